@@ -0,0 +1,126 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/types"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+// MutateResourceFunc returns a TestCheckFunc which confirms the resource exists within Azure
+// and then changes it out-of-band (that is, outside of Terraform) using the specified function
+func MutateResourceFunc(client *clients.Client, testResource types.TestResource, resourceName string, mutate func(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error) func(state *terraform.State) error {
+	return func(state *terraform.State) error {
+		ctx := client.StopContext
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		exists, err := testResource.Exists(ctx, client, rs.Primary)
+		if err != nil {
+			return fmt.Errorf("running exists func for %q: %+v", resourceName, err)
+		}
+		if exists == nil || !*exists {
+			return fmt.Errorf("%q did not exist prior to being mutated", resourceName)
+		}
+
+		if err := mutate(ctx, client, rs.Primary); err != nil {
+			return fmt.Errorf("mutating %q out-of-band: %+v", resourceName, err)
+		}
+
+		return nil
+	}
+}
+
+// DetectedDriftFunc returns a TestCheckFunc which refreshes the resource using its Read function
+// and confirms that the attributes which differ from the state are exactly those which are expected
+// to have drifted - any unexpected change (or an expected change which wasn't detected) is an error
+func DetectedDriftFunc(client *clients.Client, schemaResource *schema.Resource, resourceName string, expectedChanges []string) func(state *terraform.State) error {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		refreshed, err := schemaResource.RefreshWithoutUpgrade(rs.Primary.DeepCopy(), client)
+		if err != nil {
+			return fmt.Errorf("refreshing %q: %+v", resourceName, err)
+		}
+
+		after := make(map[string]string)
+		if refreshed != nil {
+			after = refreshed.Attributes
+		}
+
+		changed := ChangedAttributes(rs.Primary.Attributes, after)
+		if err := VerifyExpectedChanges(changed, expectedChanges); err != nil {
+			return fmt.Errorf("verifying drift detected for %q: %+v", resourceName, err)
+		}
+
+		return nil
+	}
+}
+
+// ChangedAttributes returns the sorted list of flatmap keys which differ between the two sets of attributes
+func ChangedAttributes(before, after map[string]string) []string {
+	changed := make([]string, 0)
+	for k, v := range before {
+		if other, ok := after[k]; !ok || other != v {
+			changed = append(changed, k)
+		}
+	}
+	for k := range after {
+		if _, ok := before[k]; !ok {
+			changed = append(changed, k)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// VerifyExpectedChanges confirms that every changed key is covered by one of the expected attribute
+// paths and that every expected attribute path has at least one change - where an expected path of
+// `tags` covers both `tags.%` and `tags.environment`
+func VerifyExpectedChanges(changed []string, expected []string) error {
+	if len(expected) == 0 {
+		return fmt.Errorf("at least one expected change must be specified")
+	}
+
+	matched := make(map[string]bool)
+	unexpected := make([]string, 0)
+	for _, key := range changed {
+		found := false
+		for _, path := range expected {
+			if key == path || strings.HasPrefix(key, path+".") {
+				matched[path] = true
+				found = true
+			}
+		}
+		if !found {
+			unexpected = append(unexpected, key)
+		}
+	}
+
+	if len(unexpected) > 0 {
+		return fmt.Errorf("unexpected changes to %s", strings.Join(unexpected, ", "))
+	}
+
+	missing := make([]string, 0)
+	for _, path := range expected {
+		if !matched[path] {
+			missing = append(missing, path)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("expected changes to %s but none were detected", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChangedAttributes(t *testing.T) {
+	before := map[string]string{
+		"name":             "example",
+		"tags.%":           "2",
+		"tags.environment": "Production",
+		"tags.cost_center": "MSFT",
+	}
+	after := map[string]string{
+		"name":             "example",
+		"tags.%":           "2",
+		"tags.environment": "Drifted",
+		"tags.owner":       "someone",
+	}
+
+	expected := []string{"tags.cost_center", "tags.environment", "tags.owner"}
+	if actual := ChangedAttributes(before, after); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestVerifyExpectedChanges(t *testing.T) {
+	testData := []struct {
+		changed  []string
+		expected []string
+		valid    bool
+	}{
+		{
+			// nothing expected
+			changed:  []string{"tags.%"},
+			expected: []string{},
+			valid:    false,
+		},
+		{
+			changed:  []string{"tags.%", "tags.environment"},
+			expected: []string{"tags"},
+			valid:    true,
+		},
+		{
+			changed:  []string{"tags.environment"},
+			expected: []string{"tags.environment"},
+			valid:    true,
+		},
+		{
+			// a prefix of the attribute name isn't a parent path
+			changed:  []string{"tags_extra"},
+			expected: []string{"tags"},
+			valid:    false,
+		},
+		{
+			// unexpected change
+			changed:  []string{"tags.environment", "location"},
+			expected: []string{"tags"},
+			valid:    false,
+		},
+		{
+			// expected change which wasn't detected
+			changed:  []string{"tags.environment"},
+			expected: []string{"tags", "location"},
+			valid:    false,
+		},
+		{
+			// no drift detected
+			changed:  []string{},
+			expected: []string{"tags"},
+			valid:    false,
+		},
+	}
+
+	for _, v := range testData {
+		err := VerifyExpectedChanges(v.changed, v.expected)
+		if v.valid && err != nil {
+			t.Fatalf("expected %+v / %+v to be valid but got: %+v", v.changed, v.expected, err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("expected %+v / %+v to be invalid", v.changed, v.expected)
+		}
+	}
+}
@@ -0,0 +1,410 @@
+package roundtrip
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ValueFunc returns a value for a field which can't be inferred from the Schema
+// (for example a Resource ID which must match a specific format)
+type ValueFunc func(r *rand.Rand) interface{}
+
+// maxAttempts is the number of candidate values tried for a field before giving up
+const maxAttempts = 50
+
+// stringCandidateKinds is the number of different kinds of value (e.g. a UUID or a duration) tried for strings
+const stringCandidateKinds = 8
+
+// defaultMaxItems is the number of items generated for Lists/Sets without a MaxItems
+const defaultMaxItems = 3
+
+type generator struct {
+	rand *rand.Rand
+
+	// values is a map of path (with list indices omitted) to a function returning a value for it
+	values map[string]ValueFunc
+
+	// unset tracks the fields within each generated block which haven't been specified, so
+	// that these can be distinguished from fields which have been explicitly set to a zero value
+	unset unsetFields
+}
+
+// generate returns a random value conforming to the specified Schema, in the same
+// shape which would be returned from `d.Get` - e.g. a *schema.Set for a TypeSet
+func (g generator) generate(path string, s *schema.Schema) (interface{}, error) {
+	if fn, ok := g.values[path]; ok {
+		return fn(g.rand), nil
+	}
+
+	switch s.Type {
+	case schema.TypeBool:
+		return g.rand.Intn(2) == 1, nil
+
+	case schema.TypeInt:
+		return g.generatePrimitive(path, s, g.intCandidates)
+
+	case schema.TypeFloat:
+		return g.generatePrimitive(path, s, g.floatCandidates)
+
+	case schema.TypeString:
+		return g.generatePrimitive(path, s, g.stringCandidates)
+
+	case schema.TypeMap:
+		return g.generateMap(path, s)
+
+	case schema.TypeList, schema.TypeSet:
+		return g.generateList(path, s)
+	}
+
+	return nil, fmt.Errorf("unsupported type %q for %q", s.Type.String(), path)
+}
+
+func (g generator) generateResource(path string, r *schema.Resource) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+
+	keys := make([]string, 0)
+	for k := range r.Schema {
+		keys = append(keys, k)
+	}
+	// the keys are sorted so that the output is stable for a given seed
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		s := r.Schema[k]
+		fieldPath := joinPath(path, k)
+
+		if !isConfigurable(s) || g.conflictsWithSetField(s, output) || (s.Optional && g.rand.Intn(2) == 0) {
+			output[k] = zeroValue(s)
+			if s.Default == nil {
+				g.unset.add(output, k)
+			}
+			continue
+		}
+
+		v, err := g.generate(fieldPath, s)
+		if err != nil {
+			return nil, err
+		}
+		output[k] = v
+	}
+
+	// ensure at least one field within each `ExactlyOneOf` and `AtLeastOneOf` group is set
+	for _, k := range keys {
+		s := r.Schema[k]
+		group := append(append([]string{}, s.ExactlyOneOf...), s.AtLeastOneOf...)
+		if len(group) == 0 {
+			continue
+		}
+
+		anySet := false
+		for _, other := range group {
+			if v, ok := output[lastSegment(other)]; ok && !isZero(v) {
+				anySet = true
+				break
+			}
+		}
+		if anySet {
+			continue
+		}
+
+		v, err := g.generateNonZero(joinPath(path, k), s)
+		if err != nil {
+			return nil, err
+		}
+		output[k] = v
+		g.unset.remove(output, k)
+	}
+
+	// ensure the fields within each `RequiredWith` group are set when any field within it is set
+	for _, k := range keys {
+		s := r.Schema[k]
+		if len(s.RequiredWith) == 0 || isZero(output[k]) {
+			continue
+		}
+
+		for _, other := range s.RequiredWith {
+			name := lastSegment(other)
+			otherSchema, ok := r.Schema[name]
+			if !ok || !isZero(output[name]) {
+				continue
+			}
+
+			v, err := g.generateNonZero(joinPath(path, name), otherSchema)
+			if err != nil {
+				return nil, err
+			}
+			output[name] = v
+			g.unset.remove(output, name)
+		}
+	}
+
+	return output, nil
+}
+
+// generateNonZero returns a value for the field which isn't the zero value for its type (for
+// example a List containing at least one item), which is used to satisfy the `ExactlyOneOf`
+// and `AtLeastOneOf` constraints
+func (g generator) generateNonZero(path string, s *schema.Schema) (interface{}, error) {
+	if _, ok := g.values[path]; !ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+		nonEmpty := *s
+		if nonEmpty.MinItems < 1 {
+			nonEmpty.MinItems = 1
+		}
+		return g.generateList(path, &nonEmpty)
+	}
+
+	for i := 0; i < maxAttempts; i++ {
+		v, err := g.generate(path, s)
+		if err != nil {
+			return nil, err
+		}
+		if !isZero(v) {
+			return v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to generate a non-zero value for %q - specify one using `Values`", path)
+}
+
+func (g generator) generateList(path string, s *schema.Schema) (interface{}, error) {
+	min := s.MinItems
+	if min == 0 && s.Required {
+		min = 1
+	}
+	max := s.MaxItems
+	if max == 0 {
+		max = defaultMaxItems
+	}
+	if max < min {
+		max = min
+	}
+
+	count := min + g.rand.Intn(max-min+1)
+	items := make([]interface{}, 0)
+	for i := 0; i < count; i++ {
+		switch elem := s.Elem.(type) {
+		case *schema.Resource:
+			v, err := g.generateResource(path, elem)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+
+		case *schema.Schema:
+			v, err := g.generate(path, elem)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+
+		default:
+			return nil, fmt.Errorf("unsupported Elem %T for %q", s.Elem, path)
+		}
+	}
+
+	if s.Type == schema.TypeSet {
+		return schema.NewSet(setHashFunc(s), items), nil
+	}
+
+	return items, nil
+}
+
+func (g generator) generateMap(path string, s *schema.Schema) (interface{}, error) {
+	elem := &schema.Schema{Type: schema.TypeString}
+	if v, ok := s.Elem.(*schema.Schema); ok {
+		elem = v
+	}
+
+	output := make(map[string]interface{})
+	count := g.rand.Intn(defaultMaxItems + 1)
+	for i := 0; i < count; i++ {
+		v, err := g.generate(path, elem)
+		if err != nil {
+			return nil, err
+		}
+		output[fmt.Sprintf("key%d", i)] = v
+	}
+
+	if s.ValidateFunc != nil {
+		if _, errs := s.ValidateFunc(output, path); len(errs) > 0 {
+			return nil, fmt.Errorf("unable to generate a valid value for %q - specify one using `Values`: %+v", path, errs)
+		}
+	}
+
+	return output, nil
+}
+
+// generatePrimitive tries candidate values until one passes the ValidateFunc for this field - the
+// kind of candidate is cycled through on each attempt (starting from a random kind) so that every
+// kind is tried, rather than relying on a field accepting only one kind being picked at random
+func (g generator) generatePrimitive(path string, s *schema.Schema, candidates func(hint *validationHint, kind int) interface{}) (interface{}, error) {
+	start := g.rand.Intn(stringCandidateKinds)
+	if s.ValidateFunc == nil {
+		return candidates(nil, start), nil
+	}
+
+	var hint *validationHint
+	var lastErrs []error
+	for i := 0; i < maxAttempts; i++ {
+		v := candidates(hint, (start+i)%stringCandidateKinds)
+		_, errs := s.ValidateFunc(v, path)
+		if len(errs) == 0 {
+			return v, nil
+		}
+
+		lastErrs = errs
+		if hint == nil {
+			hint = parseValidationHint(errs)
+		}
+	}
+
+	return nil, fmt.Errorf("unable to generate a valid value for %q - specify one using `Values`: %+v", path, lastErrs)
+}
+
+func (g generator) intCandidates(hint *validationHint, _ int) interface{} {
+	if hint != nil {
+		if len(hint.oneOf) > 0 {
+			if v, err := strconv.Atoi(hint.oneOf[g.rand.Intn(len(hint.oneOf))]); err == nil {
+				return v
+			}
+		}
+		if hint.min != nil || hint.max != nil {
+			return hint.between(g.rand)
+		}
+	}
+
+	return g.rand.Intn(1000)
+}
+
+func (g generator) floatCandidates(_ *validationHint, _ int) interface{} {
+	return float64(g.rand.Intn(10000)) / 100
+}
+
+func (g generator) stringCandidates(hint *validationHint, kind int) interface{} {
+	if hint != nil {
+		if len(hint.oneOf) > 0 {
+			return hint.oneOf[g.rand.Intn(len(hint.oneOf))]
+		}
+		if hint.min != nil || hint.max != nil {
+			return g.randomString(hint.between(g.rand))
+		}
+	}
+
+	switch kind {
+	case 0:
+		v, _ := uuid.GenerateUUID()
+		return v
+	case 1:
+		return fmt.Sprintf("10.%d.%d.%d", g.rand.Intn(256), g.rand.Intn(256), g.rand.Intn(256))
+	case 2:
+		return fmt.Sprintf("10.%d.0.0/16", g.rand.Intn(256))
+	case 3:
+		return fmt.Sprintf("%dm", 1+g.rand.Intn(60))
+	case 4:
+		return strconv.Itoa(g.rand.Intn(1000))
+	case 5:
+		return fmt.Sprintf("acctest-%s", g.randomString(8))
+	}
+
+	return g.randomString(1 + g.rand.Intn(16))
+}
+
+func (g generator) randomString(length int) string {
+	const charset = "abcdefghijklmnopqrstuvwxyz0123456789"
+	if length <= 0 {
+		return ""
+	}
+
+	output := make([]byte, length)
+	// the first character is always a letter, since this is commonly required
+	output[0] = charset[g.rand.Intn(26)]
+	for i := 1; i < length; i++ {
+		output[i] = charset[g.rand.Intn(len(charset))]
+	}
+	return string(output)
+}
+
+func (g generator) conflictsWithSetField(s *schema.Schema, siblings map[string]interface{}) bool {
+	conflicts := append(append([]string{}, s.ConflictsWith...), s.ExactlyOneOf...)
+	for _, other := range conflicts {
+		if v, ok := siblings[lastSegment(other)]; ok && !isZero(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// validationHint contains the constraints which can be parsed from the error messages
+// returned from the ValidateFuncs within the Plugin SDK's `validation` package
+type validationHint struct {
+	oneOf []string
+	min   *int
+	max   *int
+}
+
+var (
+	oneOfRegex   = regexp.MustCompile(`to be one of \[(.*)\], got`)
+	rangeRegex   = regexp.MustCompile(`in the range \((-?\d+) - (-?\d+)\)`)
+	atLeastRegex = regexp.MustCompile(`to be at least \((-?\d+)\)`)
+	atMostRegex  = regexp.MustCompile(`to be at most \((-?\d+)\)`)
+)
+
+func parseValidationHint(errs []error) *validationHint {
+	for _, err := range errs {
+		message := err.Error()
+
+		if match := oneOfRegex.FindStringSubmatch(message); match != nil {
+			return &validationHint{
+				oneOf: strings.Fields(match[1]),
+			}
+		}
+
+		if match := rangeRegex.FindStringSubmatch(message); match != nil {
+			min, _ := strconv.Atoi(match[1])
+			max, _ := strconv.Atoi(match[2])
+			return &validationHint{
+				min: &min,
+				max: &max,
+			}
+		}
+
+		if match := atLeastRegex.FindStringSubmatch(message); match != nil {
+			min, _ := strconv.Atoi(match[1])
+			return &validationHint{
+				min: &min,
+			}
+		}
+
+		if match := atMostRegex.FindStringSubmatch(message); match != nil {
+			max, _ := strconv.Atoi(match[1])
+			return &validationHint{
+				max: &max,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h validationHint) between(r *rand.Rand) int {
+	min := 0
+	if h.min != nil {
+		min = *h.min
+	}
+	max := min + 1000
+	if h.max != nil {
+		max = *h.max
+	}
+	if max < min {
+		return min
+	}
+
+	return min + r.Intn(max-min+1)
+}
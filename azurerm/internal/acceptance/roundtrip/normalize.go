@@ -0,0 +1,341 @@
+package roundtrip
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// unsetValue is used in place of a generated field which wasn't specified, since the flatten
+// function can either return the zero value or omit the field entirely
+type unsetValue struct{}
+
+// unsetFields is a map of generated block (by its address) to the fields within it which weren't specified - since
+// addresses can be reused once a block has been garbage collected this must be reset before generating each configuration
+type unsetFields map[uintptr]map[string]struct{}
+
+func (u unsetFields) reset() {
+	for address := range u {
+		delete(u, address)
+	}
+}
+
+func (u unsetFields) add(block map[string]interface{}, key string) {
+	if u == nil {
+		return
+	}
+
+	address := reflect.ValueOf(block).Pointer()
+	if _, ok := u[address]; !ok {
+		u[address] = make(map[string]struct{})
+	}
+	u[address][key] = struct{}{}
+}
+
+func (u unsetFields) remove(block map[string]interface{}, key string) {
+	if u == nil {
+		return
+	}
+
+	delete(u[reflect.ValueOf(block).Pointer()], key)
+}
+
+func (u unsetFields) contains(block map[string]interface{}, key string) bool {
+	if u == nil {
+		return false
+	}
+
+	_, ok := u[reflect.ValueOf(block).Pointer()][key]
+	return ok
+}
+
+// normalize converts a value (either generated, or returned from a flatten function) into
+// a canonical form which can be compared - Sets are converted into sorted Lists, typed
+// slices/maps are converted into their untyped equivalents, missing or nil Lists/Maps are
+// replaced with empty ones and non-configurable/ignored fields are removed. Missing or nil
+// scalars are intentionally kept as nil so that a zero value which has been dropped by the
+// expand or flatten function is detected - fields which weren't specified in the generated
+// configuration (tracked in `unset`) are replaced with an unsetValue
+func normalize(path string, s *schema.Schema, input interface{}, ignore map[string]struct{}, unset unsetFields) interface{} {
+	if v, ok := input.(*schema.Set); ok {
+		if v == nil {
+			input = nil
+		} else {
+			input = v.List()
+		}
+	}
+
+	value := reflect.ValueOf(input)
+	for value.IsValid() && value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			value = reflect.Value{}
+			break
+		}
+		value = value.Elem()
+	}
+
+	switch s.Type {
+	case schema.TypeBool, schema.TypeInt, schema.TypeFloat, schema.TypeString:
+		return normalizeScalar(s, value)
+
+	case schema.TypeMap:
+		elem := &schema.Schema{Type: schema.TypeString}
+		if v, ok := s.Elem.(*schema.Schema); ok {
+			elem = v
+		}
+
+		output := make(map[string]interface{})
+		if value.IsValid() && value.Kind() == reflect.Map {
+			for _, key := range value.MapKeys() {
+				output[fmt.Sprintf("%v", key.Interface())] = normalize(path, elem, value.MapIndex(key).Interface(), ignore, unset)
+			}
+		}
+		return output
+
+	case schema.TypeList, schema.TypeSet:
+		output := make([]interface{}, 0)
+		if value.IsValid() && (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) {
+			for i := 0; i < value.Len(); i++ {
+				item := value.Index(i).Interface()
+				switch elem := s.Elem.(type) {
+				case *schema.Resource:
+					output = append(output, normalizeResource(path, elem, item, ignore, unset))
+				case *schema.Schema:
+					output = append(output, normalize(path, elem, item, ignore, unset))
+				}
+			}
+		}
+
+		if s.Type == schema.TypeSet {
+			// unset, nil and zero values are sorted identically so that the items in the input
+			// and the flattened output are in the same order when these are reconciled
+			sort.SliceStable(output, func(i, j int) bool {
+				return fmt.Sprintf("%#v", sortKey(output[i])) < fmt.Sprintf("%#v", sortKey(output[j]))
+			})
+		}
+		return output
+	}
+
+	return input
+}
+
+func normalizeScalar(s *schema.Schema, value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+
+	switch s.Type {
+	case schema.TypeBool:
+		if value.Kind() == reflect.Bool {
+			return value.Bool()
+		}
+
+	case schema.TypeInt:
+		switch value.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return int(value.Int())
+		}
+
+	case schema.TypeFloat:
+		switch value.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return value.Float()
+		}
+
+	case schema.TypeString:
+		if value.Kind() == reflect.String {
+			return value.String()
+		}
+	}
+
+	// returning the raw value means a value of the wrong type shows up in the diff
+	return value.Interface()
+}
+
+func normalizeResource(path string, r *schema.Resource, input interface{}, ignore map[string]struct{}, unset unsetFields) map[string]interface{} {
+	raw := make(map[string]interface{})
+	if v, ok := input.(map[string]interface{}); ok {
+		raw = v
+	}
+
+	output := make(map[string]interface{})
+	for k, s := range r.Schema {
+		fieldPath := joinPath(path, k)
+		if !isConfigurable(s) {
+			continue
+		}
+		if _, ok := ignore[fieldPath]; ok {
+			continue
+		}
+
+		if unset.contains(raw, k) && isScalar(s) {
+			output[k] = unsetValue{}
+			continue
+		}
+
+		output[k] = normalize(fieldPath, s, raw[k], ignore, unset)
+	}
+	return output
+}
+
+// reconcile replaces each unsetValue within the normalized input with the corresponding value
+// from the normalized output, providing that value is either nil or the zero value
+func reconcile(input, output interface{}) interface{} {
+	switch v := input.(type) {
+	case unsetValue:
+		if output == nil || isZero(output) {
+			return output
+		}
+		return v
+
+	case map[string]interface{}:
+		other, ok := output.(map[string]interface{})
+		if !ok {
+			return v
+		}
+
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = reconcile(item, other[key])
+		}
+		return result
+
+	case []interface{}:
+		other, ok := output.([]interface{})
+		if !ok || len(other) != len(v) {
+			return v
+		}
+
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = reconcile(item, other[i])
+		}
+		return result
+	}
+
+	return input
+}
+
+// sortKey returns the value with unset, nil and zero scalars removed, for use when sorting the items within a Set
+func sortKey(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{})
+		for key, item := range v {
+			if item = sortKey(item); item != nil {
+				result[key] = item
+			}
+		}
+		return result
+
+	case []interface{}:
+		result := make([]interface{}, 0)
+		for _, item := range v {
+			result = append(result, sortKey(item))
+		}
+		return result
+
+	case unsetValue:
+		return nil
+	}
+
+	if isZero(input) {
+		return nil
+	}
+	return input
+}
+
+func isScalar(s *schema.Schema) bool {
+	switch s.Type {
+	case schema.TypeBool, schema.TypeInt, schema.TypeFloat, schema.TypeString:
+		return true
+	}
+	return false
+}
+
+// isConfigurable returns whether the field can be specified by a user, as such
+// Computed-only, Deprecated and Removed fields are neither generated nor compared
+func isConfigurable(s *schema.Schema) bool {
+	if !s.Required && !s.Optional {
+		return false
+	}
+
+	return s.Deprecated == "" && s.Removed == ""
+}
+
+// zeroValue returns the value `d.Get` would return for a field which hasn't been specified
+func zeroValue(s *schema.Schema) interface{} {
+	if s.Default != nil {
+		return s.Default
+	}
+
+	switch s.Type {
+	case schema.TypeBool:
+		return false
+	case schema.TypeInt:
+		return 0
+	case schema.TypeFloat:
+		return float64(0)
+	case schema.TypeString:
+		return ""
+	case schema.TypeMap:
+		return map[string]interface{}{}
+	case schema.TypeSet:
+		return schema.NewSet(setHashFunc(s), []interface{}{})
+	}
+
+	return []interface{}{}
+}
+
+func isZero(input interface{}) bool {
+	if v, ok := input.(*schema.Set); ok {
+		return v == nil || v.Len() == 0
+	}
+
+	value := reflect.ValueOf(input)
+	if !value.IsValid() {
+		return true
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	}
+
+	return value.IsZero()
+}
+
+func setHashFunc(s *schema.Schema) schema.SchemaSetFunc {
+	if s.Set != nil {
+		return s.Set
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		return schema.HashResource(elem)
+	case *schema.Schema:
+		return schema.HashSchema(elem)
+	}
+
+	return schema.HashString
+}
+
+// joinPath returns the path to a nested field - list indices are intentionally omitted
+// so that a single path (e.g. `ssh_key.key_data`) matches the field in every item
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return fmt.Sprintf("%s.%s", path, key)
+}
+
+// lastSegment returns the field name from a path used in `ConflictsWith` and friends
+// e.g. `linux_profile.0.admin_username` returns `admin_username`
+func lastSegment(path string) string {
+	segments := strings.Split(path, ".")
+	return segments[len(segments)-1]
+}
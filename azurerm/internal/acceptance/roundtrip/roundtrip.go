@@ -0,0 +1,139 @@
+package roundtrip
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// defaultIterations is the number of random configurations checked when Iterations isn't specified
+const defaultIterations = 100
+
+// defaultSeed is the seed used when neither Seed nor the `ROUNDTRIP_SEED` environment variable
+// is specified, so that the configurations generated (and thus any failures) are reproducible
+const defaultSeed = 1
+
+// seedEnvironmentVariable can be set to override the seed for every TestCase which doesn't
+// specify a Seed, e.g. to check a different set of random configurations locally
+const seedEnvironmentVariable = "ROUNDTRIP_SEED"
+
+// TestCase defines an expand/flatten pair which should be checked for symmetry
+type TestCase struct {
+	// Schema is the Schema for the field being expanded and flattened
+	// e.g. `resourceKubernetesCluster().Schema["linux_profile"]`
+	Schema *schema.Schema
+
+	// Expand converts the value for this field (in the same shape as returned from `d.Get`)
+	// into the Azure SDK model, for example by calling `expandKubernetesClusterLinuxProfile`
+	Expand func(input interface{}) (interface{}, error)
+
+	// Flatten converts the Azure SDK model returned from Expand back into the
+	// value for this field, for example by calling `flattenKubernetesClusterLinuxProfile`
+	Flatten func(input interface{}) (interface{}, error)
+
+	// Iterations is the number of random configurations which should be checked, defaults to 100
+	Iterations int
+
+	// Seed is the seed used to generate the random configurations, which defaults to the value of
+	// the `ROUNDTRIP_SEED` environment variable (or 1 when that isn't set) - this is output on
+	// failure so that a failing configuration can be reproduced
+	Seed int64
+
+	// Values is a map of path to a function returning a valid value for that field, for fields
+	// whose values can't be inferred from the Schema. Paths are relative to this field and
+	// omit list indices, for example `ssh_key.key_data`
+	Values map[string]ValueFunc
+
+	// Ignore is a list of paths (in the same format as Values) which aren't round-tripped by
+	// design - for example write-only fields which aren't returned from the API
+	Ignore []string
+}
+
+// Run generates random configurations for the field defined in the TestCase, then expands
+// and flattens each of them - failing the test if the result differs from the input
+func Run(t *testing.T, testCase TestCase) {
+	t.Helper()
+
+	if err := Verify(testCase); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Verify generates random configurations for the field defined in the TestCase, then expands
+// and flattens each of them - returning an error for the first result which differs from the input
+func Verify(testCase TestCase) error {
+	if testCase.Schema == nil {
+		return fmt.Errorf("`Schema` must be specified")
+	}
+	if testCase.Expand == nil || testCase.Flatten == nil {
+		return fmt.Errorf("both `Expand` and `Flatten` must be specified")
+	}
+
+	seed := testCase.Seed
+	if seed == 0 {
+		v, err := seedFromEnvironment()
+		if err != nil {
+			return err
+		}
+		seed = v
+	}
+	iterations := testCase.Iterations
+	if iterations == 0 {
+		iterations = defaultIterations
+	}
+
+	ignore := make(map[string]struct{})
+	for _, v := range testCase.Ignore {
+		ignore[v] = struct{}{}
+	}
+
+	gen := generator{
+		rand:   rand.New(rand.NewSource(seed)),
+		values: testCase.Values,
+		unset:  make(unsetFields),
+	}
+
+	for i := 0; i < iterations; i++ {
+		gen.unset.reset()
+		input, err := gen.generate("", testCase.Schema)
+		if err != nil {
+			return fmt.Errorf("generating configuration (seed %d): %+v", seed, err)
+		}
+
+		model, err := testCase.Expand(input)
+		if err != nil {
+			return fmt.Errorf("expanding configuration (seed %d, iteration %d): %+v\n\nInput: %#v", seed, i, err, input)
+		}
+
+		output, err := testCase.Flatten(model)
+		if err != nil {
+			return fmt.Errorf("flattening model (seed %d, iteration %d): %+v\n\nInput: %#v", seed, i, err, input)
+		}
+
+		actual := normalize("", testCase.Schema, output, ignore, nil)
+		expected := reconcile(normalize("", testCase.Schema, input, ignore, gen.unset), actual)
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			return fmt.Errorf("the flattened value differs from the input (seed %d, iteration %d) (-input +flattened):\n%s", seed, i, diff)
+		}
+	}
+
+	return nil
+}
+
+func seedFromEnvironment() (int64, error) {
+	raw := os.Getenv(seedEnvironmentVariable)
+	if raw == "" {
+		return defaultSeed, nil
+	}
+
+	seed, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing %q from the environment variable %q: %+v", raw, seedEnvironmentVariable, err)
+	}
+	return seed, nil
+}
@@ -0,0 +1,340 @@
+package roundtrip
+
+import (
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+type testModel struct {
+	Name     string
+	Count    int
+	Enabled  bool
+	Sku      string
+	Zones    []string
+	Tags     map[string]string
+	Computed string
+}
+
+func testSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(3, 10),
+				},
+				"count": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(5, 10),
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"sku": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard"}, false),
+				},
+				"zones": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"tags": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"computed": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func testExpand(input interface{}) (interface{}, error) {
+	raw := input.([]interface{})
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	v := raw[0].(map[string]interface{})
+	zones := make([]string, 0)
+	for _, zone := range v["zones"].(*schema.Set).List() {
+		zones = append(zones, zone.(string))
+	}
+	tags := make(map[string]string)
+	for k, tag := range v["tags"].(map[string]interface{}) {
+		tags[k] = tag.(string)
+	}
+
+	return &testModel{
+		Name:    v["name"].(string),
+		Count:   v["count"].(int),
+		Enabled: v["enabled"].(bool),
+		Sku:     v["sku"].(string),
+		Zones:   zones,
+		Tags:    tags,
+	}, nil
+}
+
+func testFlatten(input interface{}) (interface{}, error) {
+	model, ok := input.(*testModel)
+	if !ok || model == nil {
+		return []interface{}{}, nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":     model.Name,
+			"count":    model.Count,
+			"enabled":  model.Enabled,
+			"sku":      model.Sku,
+			"zones":    model.Zones,
+			"tags":     model.Tags,
+			"computed": "from-the-api",
+		},
+	}, nil
+}
+
+func TestRoundTripSymmetric(t *testing.T) {
+	Run(t, TestCase{
+		Schema:  testSchema(),
+		Expand:  testExpand,
+		Flatten: testFlatten,
+	})
+}
+
+func TestRoundTripAsymmetric(t *testing.T) {
+	err := Verify(TestCase{
+		Schema: testSchema(),
+		Expand: testExpand,
+		Flatten: func(input interface{}) (interface{}, error) {
+			output, err := testFlatten(input)
+			if err != nil {
+				return nil, err
+			}
+
+			// losing a field on read is the bug we're looking to detect
+			for _, item := range output.([]interface{}) {
+				delete(item.(map[string]interface{}), "sku")
+			}
+			return output, nil
+		},
+		Seed: 1,
+	})
+	if err == nil {
+		t.Fatalf("expected an error for an asymmetric expand/flatten pair but didn't get one")
+	}
+}
+
+func TestRoundTripIgnore(t *testing.T) {
+	Run(t, TestCase{
+		Schema: testSchema(),
+		Expand: testExpand,
+		Flatten: func(input interface{}) (interface{}, error) {
+			output, err := testFlatten(input)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range output.([]interface{}) {
+				delete(item.(map[string]interface{}), "sku")
+			}
+			return output, nil
+		},
+		Ignore: []string{"sku"},
+	})
+}
+
+func TestGeneratedValuesAreValid(t *testing.T) {
+	s := testSchema()
+	resource := s.Elem.(*schema.Resource)
+
+	for seed := int64(1); seed <= 100; seed++ {
+		Run(t, TestCase{
+			Schema: s,
+			Expand: func(input interface{}) (interface{}, error) {
+				for _, item := range input.([]interface{}) {
+					v := item.(map[string]interface{})
+					for _, key := range []string{"name", "count", "sku"} {
+						if key != "name" && isZero(v[key]) {
+							continue
+						}
+						if _, errs := resource.Schema[key].ValidateFunc(v[key], key); len(errs) > 0 {
+							t.Fatalf("generated an invalid value for %q (seed %d): %+v", key, seed, errs)
+						}
+					}
+				}
+				return testExpand(input)
+			},
+			Flatten:    testFlatten,
+			Iterations: 1,
+			Seed:       seed,
+		})
+	}
+}
+
+func TestRequiredWithIsSatisfied(t *testing.T) {
+	s := &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"block.0.min"},
+					ValidateFunc: validation.IntBetween(1024, 60999),
+				},
+				"min": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"block.0.max"},
+					ValidateFunc: validation.IntBetween(1024, 60999),
+				},
+			},
+		},
+	}
+
+	for seed := int64(1); seed <= 100; seed++ {
+		gen := generator{
+			rand:  rand.New(rand.NewSource(seed)),
+			unset: make(unsetFields),
+		}
+		for i := 0; i < 10; i++ {
+			v, err := gen.generate("", s)
+			if err != nil {
+				t.Fatalf("generating (seed %d): %+v", seed, err)
+			}
+
+			block := v.([]interface{})[0].(map[string]interface{})
+			if isZero(block["max"]) != isZero(block["min"]) {
+				t.Fatalf("expected both or neither of `max` and `min` to be set but got %+v (seed %d, iteration %d)", block, seed, i)
+			}
+		}
+	}
+}
+
+func TestAtLeastOneOfIsSatisfied(t *testing.T) {
+	s := &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed": {
+					Type:         schema.TypeSet,
+					Optional:     true,
+					AtLeastOneOf: []string{"block.0.allowed", "block.0.not_allowed"},
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"not_allowed": {
+					Type:         schema.TypeSet,
+					Optional:     true,
+					AtLeastOneOf: []string{"block.0.allowed", "block.0.not_allowed"},
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+
+	for seed := int64(1); seed <= 100; seed++ {
+		gen := generator{
+			rand:  rand.New(rand.NewSource(seed)),
+			unset: make(unsetFields),
+		}
+		for i := 0; i < 10; i++ {
+			v, err := gen.generate("", s)
+			if err != nil {
+				t.Fatalf("generating (seed %d): %+v", seed, err)
+			}
+
+			block := v.([]interface{})[0].(map[string]interface{})
+			if isZero(block["allowed"]) && isZero(block["not_allowed"]) {
+				t.Fatalf("expected at least one of `allowed` and `not_allowed` to be set (seed %d, iteration %d)", seed, i)
+			}
+		}
+	}
+}
+
+func TestRoundTripDroppedZeroValue(t *testing.T) {
+	s := &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"count": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 1),
+				},
+			},
+		},
+	}
+
+	err := Verify(TestCase{
+		Schema: s,
+		Expand: func(input interface{}) (interface{}, error) {
+			v := input.([]interface{})[0].(map[string]interface{})
+
+			// dropping an explicit zero value is the bug we're looking to detect
+			if count := v["count"].(int); count != 0 {
+				return &count, nil
+			}
+			return (*int)(nil), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return []interface{}{
+				map[string]interface{}{
+					"count": input.(*int),
+				},
+			}, nil
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected an error for an expand function which drops a zero value but didn't get one")
+	}
+}
+
+func TestSeedFromEnvironment(t *testing.T) {
+	defer os.Unsetenv(seedEnvironmentVariable)
+
+	os.Unsetenv(seedEnvironmentVariable)
+	if seed, err := seedFromEnvironment(); err != nil || seed != defaultSeed {
+		t.Fatalf("expected the default seed %d but got %d: %+v", defaultSeed, seed, err)
+	}
+
+	os.Setenv(seedEnvironmentVariable, "1792371921876906997")
+	if seed, err := seedFromEnvironment(); err != nil || seed != 1792371921876906997 {
+		t.Fatalf("expected the seed from the environment but got %d: %+v", seed, err)
+	}
+
+	os.Setenv(seedEnvironmentVariable, "not-a-number")
+	if _, err := seedFromEnvironment(); err == nil {
+		t.Fatalf("expected an error for an invalid seed but didn't get one")
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/helpers"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/testclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/types"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
 )
 
 type DisappearsStepData struct {
@@ -49,6 +51,77 @@ func (td TestData) DisappearsStep(data DisappearsStepData) resource.TestStep {
 	}
 }
 
+type DriftStepData struct {
+	// Config is a function which returns the Terraform Configuration which should be used for this step
+	Config func(data TestData) string
+
+	// TestResource is a reference to a TestResource which can confirm the resource exists
+	TestResource types.TestResource
+
+	// Mutate is a function which changes the resource within Azure out-of-band
+	// (for example updating a tag, disabling a setting or deleting a child resource)
+	// such that the next plan should show a diff
+	Mutate ClientMutateFunc
+
+	// ExpectedChanges are the attribute paths (for example `tags` or `tags.environment`) which
+	// are expected to differ once the resource has been mutated - any other change is an error
+	ExpectedChanges []string
+
+	// Checks are (optional) TestCheckFuncs which are run once the configuration has
+	// been re-applied, to confirm the resource has converged
+	Checks []resource.TestCheckFunc
+}
+
+// DriftSteps returns the Test Steps required to test that the Read function of a resource
+// correctly detects drift. The first step applies the configuration, then mutates the
+// resource within Azure and confirms that refreshing the resource detects changes to exactly
+// the expected attributes (and as such that the plan at the end of this shows a diff) - the
+// second step then re-applies the configuration and expects that the plan is empty
+func (td TestData) DriftSteps(data DriftStepData) []resource.TestStep {
+	config := data.Config(td)
+
+	checks := []resource.TestCheckFunc{
+		func(state *terraform.State) error {
+			client, err := testclient.Build()
+			if err != nil {
+				return fmt.Errorf("building client: %+v", err)
+			}
+			return helpers.ExistsInAzure(client, data.TestResource, td.ResourceName)(state)
+		},
+	}
+	checks = append(checks, data.Checks...)
+
+	return []resource.TestStep{
+		{
+			Config: config,
+			Check: func(state *terraform.State) error {
+				client, err := testclient.Build()
+				if err != nil {
+					return fmt.Errorf("building client: %+v", err)
+				}
+
+				providerResource, ok := provider.TestAzureProvider().(*schema.Provider).ResourcesMap[td.ResourceType]
+				if !ok {
+					return fmt.Errorf("Resource %q was not found in the Provider", td.ResourceType)
+				}
+
+				if err := helpers.MutateResourceFunc(client, data.TestResource, td.ResourceName, data.Mutate)(state); err != nil {
+					return err
+				}
+				return helpers.DetectedDriftFunc(client, providerResource, td.ResourceName, data.ExpectedChanges)(state)
+			},
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: config,
+			Check:  resource.ComposeTestCheckFunc(checks...),
+		},
+	}
+}
+
+// ClientMutateFunc is a function which changes a resource within Azure using the provider clients
+type ClientMutateFunc func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error
+
 type ClientCheckFunc func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error
 
 // CheckWithClient returns a TestCheckFunc which will call a ClientCheckFunc
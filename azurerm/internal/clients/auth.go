@@ -13,6 +13,7 @@ type ResourceManagerAccount struct {
 	ClientId                         string
 	Environment                      azure.Environment
 	ObjectId                         string
+	ResourceProvidersToRegister      map[string]struct{}
 	SkipResourceProviderRegistration bool
 	SubscriptionId                   string
 	TenantId                         string
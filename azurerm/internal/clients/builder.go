@@ -22,6 +22,7 @@ type ClientBuilder struct {
 	CustomCorrelationRequestID  string
 	DisableTerraformPartnerID   bool
 	PartnerId                   string
+	ResourceProvidersToRegister map[string]struct{}
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 	TerraformVersion            string
@@ -61,6 +62,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("Error building account: %+v", err)
 	}
+	account.ResourceProvidersToRegister = builder.ResourceProvidersToRegister
 
 	client := Client{
 		Account: account,
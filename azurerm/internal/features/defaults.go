@@ -13,6 +13,9 @@ func Default() UserFeatures {
 		Network: NetworkFeatures{
 			RelaxedLocking: false,
 		},
+		PermissionChecks: PermissionChecksFeatures{
+			Enabled: false,
+		},
 		TemplateDeployment: TemplateDeploymentFeatures{
 			DeleteNestedItemsDuringDeletion: true,
 		},
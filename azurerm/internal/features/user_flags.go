@@ -7,6 +7,7 @@ type UserFeatures struct {
 	Network                NetworkFeatures
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
+	PermissionChecks       PermissionChecksFeatures
 }
 
 type VirtualMachineFeatures struct {
@@ -34,3 +35,7 @@ type TemplateDeploymentFeatures struct {
 type LogAnalyticsWorkspaceFeatures struct {
 	PermanentlyDeleteOnDestroy bool
 }
+
+type PermissionChecksFeatures struct {
+	Enabled bool
+}
@@ -0,0 +1,218 @@
+package permissions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+)
+
+// resourceScope describes an Azure Resource Manager Resource, parsed from its Resource ID
+type resourceScope struct {
+	ResourceGroup string
+
+	// ProviderNamespace is the Resource Provider e.g. `Microsoft.Network`
+	ProviderNamespace string
+
+	// ParentResourcePath is the path to the parent resource (for nested resources)
+	// e.g. `virtualNetworks/network1` for a Subnet
+	ParentResourcePath string
+
+	// ResourceTypes are the (nested) resource types e.g. `virtualNetworks` and `subnets`
+	ResourceTypes []string
+
+	// ResourceName is the name of the resource itself
+	ResourceName string
+}
+
+// parseResourceScope parses an Azure Resource Manager Resource ID into a resourceScope
+// returning nil when the ID isn't scoped to a Resource Group (for example Data Plane IDs)
+func parseResourceScope(id string) *resourceScope {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments) < 4 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") {
+		return nil
+	}
+
+	scope := resourceScope{
+		ResourceGroup: segments[3],
+	}
+
+	if len(segments) == 4 {
+		scope.ProviderNamespace = "Microsoft.Resources"
+		scope.ResourceTypes = []string{"subscriptions", "resourceGroups"}
+		return &scope
+	}
+
+	if len(segments) < 8 || !strings.EqualFold(segments[4], "providers") {
+		return nil
+	}
+
+	scope.ProviderNamespace = segments[5]
+	pairs := segments[6:]
+	if len(pairs)%2 != 0 {
+		return nil
+	}
+
+	parentPath := make([]string, 0)
+	for i := 0; i < len(pairs); i += 2 {
+		if strings.EqualFold(pairs[i], "providers") {
+			// an extension resource, the permissions for which are checked against the Resource Group
+			return parseExtensionResourceScope(scope.ResourceGroup, pairs[i+1], pairs[i+2:])
+		}
+
+		scope.ResourceTypes = append(scope.ResourceTypes, pairs[i])
+		if i+2 < len(pairs) {
+			parentPath = append(parentPath, pairs[i], pairs[i+1])
+		} else {
+			scope.ResourceName = pairs[i+1]
+		}
+	}
+	scope.ParentResourcePath = strings.Join(parentPath, "/")
+
+	return &scope
+}
+
+// parseExtensionResourceScope parses the segments following the (last) Resource Provider of an Extension Resource
+// e.g. `locks/lock1` into a resourceScope for the Resource Group, since extension resources can be nested
+// within any resource - returning nil if these segments don't describe a resource
+func parseExtensionResourceScope(resourceGroup, providerNamespace string, pairs []string) *resourceScope {
+	if len(pairs) < 2 || len(pairs)%2 != 0 {
+		return nil
+	}
+
+	for i := 0; i < len(pairs); i += 2 {
+		if strings.EqualFold(pairs[i], "providers") {
+			return parseExtensionResourceScope(resourceGroup, pairs[i+1], pairs[i+2:])
+		}
+	}
+
+	scope := resourceScope{
+		ResourceGroup:     resourceGroup,
+		ProviderNamespace: providerNamespace,
+	}
+	for i := 0; i < len(pairs); i += 2 {
+		scope.ResourceTypes = append(scope.ResourceTypes, pairs[i])
+	}
+
+	return &scope
+}
+
+// scopeForResourceType returns a resourceScope for the specified Terraform Resource Type within the specified
+// Resource Group, used when creating a resource (where the Resource ID isn't known) - returning nil when the
+// Azure Resource Manager Resource Type for this Terraform Resource Type isn't known
+func scopeForResourceType(resourceGroup, resourceType string) *resourceScope {
+	armResourceType, ok := armResourceTypes[resourceType]
+	if !ok {
+		return nil
+	}
+
+	segments := strings.Split(armResourceType, "/")
+	if len(segments) < 2 {
+		return nil
+	}
+
+	return &resourceScope{
+		ResourceGroup:     resourceGroup,
+		ProviderNamespace: segments[0],
+		ResourceTypes:     segments[1:],
+	}
+}
+
+// scopeForResource returns a resourceScope for the existing resource of the specified Terraform Resource Type
+// with the specified Resource ID - returning nil when the Azure Resource Manager Resource Type for this Terraform
+// Resource Type isn't known, or when the Resource ID isn't scoped to a Resource Group (e.g. Data Plane IDs).
+//
+// Where the Resource ID doesn't describe the Azure Resource Manager Resource Type (for example, where the
+// Terraform Resource is a property of another resource) the permissions are checked against the Resource Group.
+func scopeForResource(resourceType, id string) *resourceScope {
+	armResourceType, ok := armResourceTypes[resourceType]
+	if !ok {
+		return nil
+	}
+
+	scope := parseResourceScope(id)
+	if scope == nil {
+		return nil
+	}
+
+	if !strings.EqualFold(fmt.Sprintf("%s/%s", scope.ProviderNamespace, strings.Join(scope.ResourceTypes, "/")), armResourceType) {
+		return scopeForResourceType(scope.ResourceGroup, resourceType)
+	}
+
+	return scope
+}
+
+// action returns the Authorization Action for the specified operation against this
+// resource, for example `Microsoft.Network/virtualNetworks/subnets/write`
+func (s resourceScope) action(operation string) string {
+	if s.ProviderNamespace == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%s/%s", s.ProviderNamespace, strings.Join(s.ResourceTypes, "/"), operation)
+}
+
+// isResource returns whether this scope refers to a specific resource (rather than a Resource Group)
+func (s resourceScope) isResource() bool {
+	return s.ResourceName != ""
+}
+
+// String returns a description of this scope for use in messages, for example
+// `Microsoft.Network/virtualNetworks "network1" (Resource Group "group1")`
+func (s resourceScope) String() string {
+	if !s.isResource() {
+		return fmt.Sprintf("Resource Group %q", s.ResourceGroup)
+	}
+
+	return fmt.Sprintf("%s/%s %q (Resource Group %q)", s.ProviderNamespace, strings.Join(s.ResourceTypes, "/"), s.ResourceName, s.ResourceGroup)
+}
+
+// cacheKey returns the key used to cache the permissions for this scope, which are retrieved
+// for the resource itself when it exists - otherwise for the Resource Group
+func (s resourceScope) cacheKey() string {
+	if !s.isResource() {
+		return strings.ToLower(s.ResourceGroup)
+	}
+
+	return strings.ToLower(fmt.Sprintf("%s/%s/%s/%s/%s", s.ResourceGroup, s.ProviderNamespace, s.ParentResourcePath, strings.Join(s.ResourceTypes, "/"), s.ResourceName))
+}
+
+// actionIsAllowed returns whether the specified action is allowed by any of the permissions
+func actionIsAllowed(permissions []authorization.Permission, action string) bool {
+	for _, permission := range permissions {
+		if !matchesAny(permission.Actions, action) {
+			continue
+		}
+
+		if matchesAny(permission.NotActions, action) {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func matchesAny(patterns *[]string, action string) bool {
+	if patterns == nil {
+		return false
+	}
+
+	for _, pattern := range *patterns {
+		if actionMatchesPattern(pattern, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// actionMatchesPattern returns whether the action matches the pattern used in a Role Definition
+// where a `*` matches zero or more characters - both of which are case-insensitive
+func actionMatchesPattern(pattern, action string) bool {
+	expression := strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+	matched, err := regexp.MatchString(fmt.Sprintf("(?i)^%s$", expression), action)
+	return err == nil && matched
+}
@@ -0,0 +1,281 @@
+package permissions
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+)
+
+func TestParseResourceScope(t *testing.T) {
+	testData := []struct {
+		Input          string
+		Expected       *resourceScope
+		ExpectedAction string
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected: &resourceScope{
+				ResourceGroup:     "group1",
+				ProviderNamespace: "Microsoft.Resources",
+				ResourceTypes:     []string{"subscriptions", "resourceGroups"},
+			},
+			ExpectedAction: "Microsoft.Resources/subscriptions/resourceGroups/write",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: &resourceScope{
+				ResourceGroup:     "group1",
+				ProviderNamespace: "Microsoft.Network",
+				ResourceTypes:     []string{"virtualNetworks"},
+				ResourceName:      "network1",
+			},
+			ExpectedAction: "Microsoft.Network/virtualNetworks/write",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &resourceScope{
+				ResourceGroup:      "group1",
+				ProviderNamespace:  "Microsoft.Network",
+				ParentResourcePath: "virtualNetworks/network1",
+				ResourceTypes:      []string{"virtualNetworks", "subnets"},
+				ResourceName:       "subnet1",
+			},
+			ExpectedAction: "Microsoft.Network/virtualNetworks/subnets/write",
+		},
+		{
+			// missing the name
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets",
+			Expected: nil,
+		},
+		{
+			// an extension resource
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &resourceScope{
+				ResourceGroup:     "group1",
+				ProviderNamespace: "Microsoft.Authorization",
+				ResourceTypes:     []string{"locks"},
+			},
+			ExpectedAction: "Microsoft.Authorization/locks/write",
+		},
+		{
+			// an extension resource missing the name
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual := parseResourceScope(v.Input)
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+
+		if actual != nil {
+			if action := actual.action("write"); action != v.ExpectedAction {
+				t.Fatalf("Expected the action to be %q but got %q", v.ExpectedAction, action)
+			}
+		}
+	}
+}
+
+func TestScopeForResourceType(t *testing.T) {
+	testData := []struct {
+		ResourceType   string
+		Expected       *resourceScope
+		ExpectedAction string
+	}{
+		{
+			ResourceType: "azurerm_does_not_exist",
+			Expected:     nil,
+		},
+		{
+			ResourceType: "azurerm_virtual_network",
+			Expected: &resourceScope{
+				ResourceGroup:     "group1",
+				ProviderNamespace: "Microsoft.Network",
+				ResourceTypes:     []string{"virtualNetworks"},
+			},
+			ExpectedAction: "Microsoft.Network/virtualNetworks/write",
+		},
+		{
+			ResourceType: "azurerm_subnet",
+			Expected: &resourceScope{
+				ResourceGroup:     "group1",
+				ProviderNamespace: "Microsoft.Network",
+				ResourceTypes:     []string{"virtualNetworks", "subnets"},
+			},
+			ExpectedAction: "Microsoft.Network/virtualNetworks/subnets/write",
+		},
+		{
+			ResourceType: "azurerm_management_lock",
+			Expected: &resourceScope{
+				ResourceGroup:     "group1",
+				ProviderNamespace: "Microsoft.Authorization",
+				ResourceTypes:     []string{"locks"},
+			},
+			ExpectedAction: "Microsoft.Authorization/locks/write",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.ResourceType)
+
+		actual := scopeForResourceType("group1", v.ResourceType)
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+
+		if actual != nil {
+			if action := actual.action("write"); action != v.ExpectedAction {
+				t.Fatalf("Expected the action to be %q but got %q", v.ExpectedAction, action)
+			}
+
+			if actual.isResource() {
+				t.Fatalf("Expected the permissions to be checked against the Resource Group")
+			}
+		}
+	}
+}
+
+func TestScopeForResource(t *testing.T) {
+	testData := []struct {
+		ResourceType   string
+		Input          string
+		Expected       *resourceScope
+		ExpectedAction string
+	}{
+		{
+			// the Resource Type isn't known
+			ResourceType: "azurerm_does_not_exist",
+			Input:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected:     nil,
+		},
+		{
+			// a Data Plane ID
+			ResourceType: "azurerm_virtual_network",
+			Input:        "https://account1.blob.core.windows.net/container1",
+			Expected:     nil,
+		},
+		{
+			ResourceType: "azurerm_subnet",
+			Input:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &resourceScope{
+				ResourceGroup:      "group1",
+				ProviderNamespace:  "Microsoft.Network",
+				ParentResourcePath: "virtualNetworks/network1",
+				ResourceTypes:      []string{"virtualNetworks", "subnets"},
+				ResourceName:       "subnet1",
+			},
+			ExpectedAction: "Microsoft.Network/virtualNetworks/subnets/write",
+		},
+		{
+			// the Resource ID doesn't describe the Resource Type, so this is checked against the Resource Group
+			ResourceType: "azurerm_key_vault_access_policy",
+			Input:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/objectId/11111111-1111-1111-1111-111111111111",
+			Expected: &resourceScope{
+				ResourceGroup:     "group1",
+				ProviderNamespace: "Microsoft.KeyVault",
+				ResourceTypes:     []string{"vaults", "accessPolicies"},
+			},
+			ExpectedAction: "Microsoft.KeyVault/vaults/accessPolicies/write",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual := scopeForResource(v.ResourceType, v.Input)
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+
+		if actual != nil {
+			if action := actual.action("write"); action != v.ExpectedAction {
+				t.Fatalf("Expected the action to be %q but got %q", v.ExpectedAction, action)
+			}
+		}
+	}
+}
+
+func TestActionIsAllowed(t *testing.T) {
+	permissions := func(actions []string, notActions []string) []authorization.Permission {
+		return []authorization.Permission{
+			{
+				Actions:    &actions,
+				NotActions: &notActions,
+			},
+		}
+	}
+
+	testData := []struct {
+		Name        string
+		Permissions []authorization.Permission
+		Action      string
+		Expected    bool
+	}{
+		{
+			Name:        "No Permissions",
+			Permissions: []authorization.Permission{},
+			Action:      "Microsoft.Network/virtualNetworks/write",
+			Expected:    false,
+		},
+		{
+			Name:        "Owner",
+			Permissions: permissions([]string{"*"}, []string{}),
+			Action:      "Microsoft.Network/virtualNetworks/write",
+			Expected:    true,
+		},
+		{
+			Name:        "Reader",
+			Permissions: permissions([]string{"*/read"}, []string{}),
+			Action:      "Microsoft.Network/virtualNetworks/write",
+			Expected:    false,
+		},
+		{
+			Name:        "Provider Wildcard",
+			Permissions: permissions([]string{"Microsoft.Network/*"}, []string{}),
+			Action:      "Microsoft.Network/virtualNetworks/subnets/write",
+			Expected:    true,
+		},
+		{
+			Name:        "Case Insensitive",
+			Permissions: permissions([]string{"microsoft.network/virtualnetworks/WRITE"}, []string{}),
+			Action:      "Microsoft.Network/virtualNetworks/write",
+			Expected:    true,
+		},
+		{
+			Name:        "Excluded by Not Actions",
+			Permissions: permissions([]string{"*"}, []string{"Microsoft.Network/virtualNetworks/*"}),
+			Action:      "Microsoft.Network/virtualNetworks/write",
+			Expected:    false,
+		},
+		{
+			Name: "Allowed by another Permission",
+			Permissions: append(
+				permissions([]string{"*"}, []string{"Microsoft.Network/virtualNetworks/*"}),
+				permissions([]string{"Microsoft.Network/virtualNetworks/write"}, []string{})...,
+			),
+			Action:   "Microsoft.Network/virtualNetworks/write",
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if actual := actionIsAllowed(v.Permissions, v.Action); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
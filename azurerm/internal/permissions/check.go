@@ -0,0 +1,247 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+//go:generate go run ../tools/generator-permissions/main.go -path=../../../
+
+// the effective permissions for a given scope are cached, since these are retrieved for every resource
+// within the plan - however these can change (for example when a Role Assignment is provisioned) so
+// are only cached for a short period of time
+const (
+	cacheDuration     = 5 * time.Minute
+	cacheLockCategory = "azurerm_permissions"
+)
+
+type cachedPermissions struct {
+	permissions *[]authorization.Permission
+	expiresAt   time.Time
+}
+
+var (
+	cache     = map[string]cachedPermissions{}
+	cacheLock = sync.Mutex{}
+)
+
+// WrapCustomizeDiff wraps the CustomizeDiff function for the specified Resource so that (when
+// the `permission_checks` feature is enabled) the permissions of the authenticated principal are
+// checked against the changes being planned for this resource - prior to anything being changed.
+//
+// Since Terraform doesn't call the Provider when planning to destroy a resource, the permissions
+// required to delete a resource can only be checked during the plan when it's being replaced.
+func WrapCustomizeDiff(resourceType string, resource *schema.Resource) {
+	existing := resource.CustomizeDiff
+	_, hasResourceGroup := resource.Schema["resource_group_name"]
+
+	resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if existing != nil {
+			if err := existing(d, meta); err != nil {
+				return err
+			}
+		}
+
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil || !client.Features.PermissionChecks.Enabled {
+			return nil
+		}
+
+		missing, err := missingPermissionsForDiff(client, resourceType, resource, d, hasResourceGroup)
+		if err != nil {
+			// the permissions check is best-effort, so shouldn't block the plan
+			log.Printf("[WARN] Unable to check the permissions for %q: %+v", resourceType, err)
+			return nil
+		}
+
+		if len(missing) == 0 {
+			return nil
+		}
+
+		return fmt.Errorf("the authenticated principal (Object ID %q) is missing the following permissions required for the changes to this %q:\n\n* %s", client.Account.ObjectId, resourceType, strings.Join(missing, "\n* "))
+	}
+}
+
+func missingPermissionsForDiff(client *clients.Client, resourceType string, resource *schema.Resource, d *schema.ResourceDiff, hasResourceGroup bool) ([]string, error) {
+	ctx := client.StopContext
+
+	// when creating a resource the Resource ID isn't available, so the Resource Type is used to determine
+	// the action required - which is checked against the Resource Group the resource is being created in
+	if d.Id() == "" {
+		if !hasResourceGroup || !d.NewValueKnown("resource_group_name") {
+			return nil, nil
+		}
+
+		resourceGroup := d.Get("resource_group_name").(string)
+		if resourceGroup == "" {
+			return nil, nil
+		}
+
+		scope := scopeForResourceType(resourceGroup, resourceType)
+		if scope == nil {
+			log.Printf("[DEBUG] The Resource Manager Resource Type for %q isn't known - skipping the permissions check", resourceType)
+			return nil, nil
+		}
+
+		return missingPermissionsForScope(ctx, client, *scope, []string{"write"})
+	}
+
+	changedKeys := d.GetChangedKeysPrefix("")
+	if len(changedKeys) == 0 {
+		return nil, nil
+	}
+
+	scope := scopeForResource(resourceType, d.Id())
+	if scope == nil {
+		log.Printf("[DEBUG] The Resource Manager Resource Type for %q (%q) isn't known - skipping the permissions check", resourceType, d.Id())
+		return nil, nil
+	}
+
+	operations := []string{"write"}
+	if requiresReplacement(resource.Schema, changedKeys) {
+		operations = append(operations, "delete")
+	}
+
+	return missingPermissionsForScope(ctx, client, *scope, operations)
+}
+
+// missingPermissionsForScope returns the Authorization Actions for the specified operations
+// which the authenticated principal is missing at the specified scope
+func missingPermissionsForScope(ctx context.Context, client *clients.Client, scope resourceScope, operations []string) ([]string, error) {
+	permissions, err := permissionsForScope(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+	if permissions == nil {
+		// the scope doesn't exist yet
+		return nil, nil
+	}
+
+	missing := make([]string, 0)
+	for _, operation := range operations {
+		action := scope.action(operation)
+		if !actionIsAllowed(*permissions, action) {
+			missing = append(missing, fmt.Sprintf("%s (on %s)", action, scope))
+		}
+	}
+
+	return missing, nil
+}
+
+// requiresReplacement returns whether any of the changed keys (e.g. `network_rules.0.bypass`)
+// is for a field marked as ForceNew, and so requires the resource to be replaced
+func requiresReplacement(schemaMap map[string]*schema.Schema, changedKeys []string) bool {
+	for _, key := range changedKeys {
+		if fieldRequiresReplacement(schemaMap, strings.Split(key, ".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func fieldRequiresReplacement(schemaMap map[string]*schema.Schema, path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+
+	v, ok := schemaMap[path[0]]
+	if !ok {
+		return false
+	}
+	if v.ForceNew {
+		return true
+	}
+
+	// nested blocks are keyed by their index (or hash) - e.g. `network_rules.0.bypass`
+	nested, ok := v.Elem.(*schema.Resource)
+	if !ok || len(path) < 3 {
+		return false
+	}
+
+	return fieldRequiresReplacement(nested.Schema, path[2:])
+}
+
+// permissionsForScope returns the effective permissions for the authenticated principal at the specified
+// scope, either a Resource Group or a Resource within it - returning nil if the scope doesn't exist
+func permissionsForScope(ctx context.Context, client *clients.Client, scope resourceScope) (*[]authorization.Permission, error) {
+	key := scope.cacheKey()
+
+	// lock on the scope (rather than the cache) so that the permissions for other scopes can be
+	// retrieved in parallel, whilst only retrieving the permissions for each scope once
+	locks.ByName(key, cacheLockCategory)
+	defer locks.UnlockByName(key, cacheLockCategory)
+
+	if v, ok := cachedPermissionsForKey(key); ok {
+		return v, nil
+	}
+
+	permissions, err := listPermissionsForScope(ctx, client.Authorization.PermissionsClient, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheLock.Lock()
+	cache[key] = cachedPermissions{
+		permissions: permissions,
+		expiresAt:   time.Now().Add(cacheDuration),
+	}
+	cacheLock.Unlock()
+
+	return permissions, nil
+}
+
+func cachedPermissionsForKey(key string) (*[]authorization.Permission, bool) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	v, ok := cache[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(v.expiresAt) {
+		delete(cache, key)
+		return nil, false
+	}
+
+	return v.permissions, true
+}
+
+func listPermissionsForScope(ctx context.Context, client *authorization.PermissionsClient, scope resourceScope) (*[]authorization.Permission, error) {
+	var iterator authorization.PermissionGetResultIterator
+	var err error
+	if scope.isResource() {
+		resourceType := scope.ResourceTypes[len(scope.ResourceTypes)-1]
+		iterator, err = client.ListForResourceComplete(ctx, scope.ResourceGroup, scope.ProviderNamespace, scope.ParentResourcePath, resourceType, scope.ResourceName)
+	} else {
+		iterator, err = client.ListForResourceGroupComplete(ctx, scope.ResourceGroup)
+	}
+	if err != nil {
+		if utils.ResponseWasNotFound(iterator.Response().Response) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("listing permissions for %s: %+v", scope, err)
+	}
+
+	permissions := make([]authorization.Permission, 0)
+	for iterator.NotDone() {
+		permissions = append(permissions, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing permissions for %s: %+v", scope, err)
+		}
+	}
+
+	return &permissions, nil
+}
@@ -0,0 +1,463 @@
+package permissions
+
+// NOTE: this file is generated from the Import section of the website docs - manual changes will be lost
+//       to re-generate this file, run 'make generate' in the root of the repository
+
+// armResourceTypes maps the Terraform Resource Type to the Azure Resource Manager Resource Type
+// which is used to determine the Authorization Action required to create a resource
+var armResourceTypes = map[string]string{
+	"azurerm_advanced_threat_protection":                               "Microsoft.Security/advancedThreatProtectionSettings",
+	"azurerm_analysis_services_server":                                 "Microsoft.AnalysisServices/servers",
+	"azurerm_api_management":                                           "Microsoft.ApiManagement/service",
+	"azurerm_api_management_api":                                       "Microsoft.ApiManagement/service/apis",
+	"azurerm_api_management_api_diagnostic":                            "Microsoft.ApiManagement/service/apis/diagnostics",
+	"azurerm_api_management_api_operation":                             "Microsoft.ApiManagement/service/apis/operations",
+	"azurerm_api_management_api_operation_policy":                      "Microsoft.ApiManagement/service/apis/operations/policies",
+	"azurerm_api_management_api_policy":                                "Microsoft.ApiManagement/service/apis/policies",
+	"azurerm_api_management_api_schema":                                "Microsoft.ApiManagement/service/apis/schemas",
+	"azurerm_api_management_api_version_set":                           "Microsoft.ApiManagement/service/apiVersionSets",
+	"azurerm_api_management_authorization_server":                      "Microsoft.ApiManagement/service/authorizationServers",
+	"azurerm_api_management_backend":                                   "Microsoft.ApiManagement/service/backends",
+	"azurerm_api_management_certificate":                               "Microsoft.ApiManagement/service/certificates",
+	"azurerm_api_management_custom_domain":                             "Microsoft.ApiManagement/service",
+	"azurerm_api_management_diagnostic":                                "Microsoft.ApiManagement/service/diagnostics",
+	"azurerm_api_management_group":                                     "Microsoft.ApiManagement/service/groups",
+	"azurerm_api_management_group_user":                                "Microsoft.ApiManagement/service/groups/users",
+	"azurerm_api_management_identity_provider_aad":                     "Microsoft.ApiManagement/service/identityProviders",
+	"azurerm_api_management_identity_provider_facebook":                "Microsoft.ApiManagement/service/identityProviders",
+	"azurerm_api_management_identity_provider_google":                  "Microsoft.ApiManagement/service/identityProviders",
+	"azurerm_api_management_identity_provider_microsoft":               "Microsoft.ApiManagement/service/identityProviders",
+	"azurerm_api_management_identity_provider_twitter":                 "Microsoft.ApiManagement/service/identityProviders",
+	"azurerm_api_management_named_value":                               "Microsoft.ApiManagement/service/namedValues",
+	"azurerm_api_management_openid_connect_provider":                   "Microsoft.ApiManagement/service/openidConnectProviders",
+	"azurerm_api_management_policy":                                    "Microsoft.ApiManagement/service/policies",
+	"azurerm_api_management_product":                                   "Microsoft.ApiManagement/service/products",
+	"azurerm_api_management_product_api":                               "Microsoft.ApiManagement/service/products/apis",
+	"azurerm_api_management_product_group":                             "Microsoft.ApiManagement/service/products/groups",
+	"azurerm_api_management_product_policy":                            "Microsoft.ApiManagement/service/products/policies",
+	"azurerm_api_management_property":                                  "Microsoft.ApiManagement/service/namedValues",
+	"azurerm_api_management_subscription":                              "Microsoft.ApiManagement/service/subscriptions",
+	"azurerm_api_management_user":                                      "Microsoft.ApiManagement/service/users",
+	"azurerm_app_certificate_order":                                    "Microsoft.CertificateRegistration/certificateOrders",
+	"azurerm_app_configuration":                                        "Microsoft.AppConfiguration/configurationStores",
+	"azurerm_app_service":                                              "Microsoft.Web/sites",
+	"azurerm_app_service_certificate":                                  "Microsoft.Web/certificates",
+	"azurerm_app_service_certificate_binding":                          "Microsoft.Web/sites/hostNameBindings",
+	"azurerm_app_service_custom_hostname_binding":                      "Microsoft.Web/sites/hostNameBindings",
+	"azurerm_app_service_environment":                                  "Microsoft.Web/hostingEnvironments",
+	"azurerm_app_service_hybrid_connection":                            "Microsoft.Web/sites/hybridConnectionNamespaces/relays",
+	"azurerm_app_service_managed_certificate":                          "Microsoft.Web/certificates",
+	"azurerm_app_service_plan":                                         "Microsoft.Web/serverfarms",
+	"azurerm_app_service_slot":                                         "Microsoft.Web/sites/slots",
+	"azurerm_application_gateway":                                      "Microsoft.Network/applicationGateways",
+	"azurerm_application_insights":                                     "microsoft.insights/components",
+	"azurerm_application_insights_api_key":                             "microsoft.insights/components/apikeys",
+	"azurerm_application_insights_web_test":                            "microsoft.insights/webtests",
+	"azurerm_application_security_group":                               "Microsoft.Network/applicationSecurityGroups",
+	"azurerm_attestation_provider":                                     "Microsoft.Attestation/attestationProviders",
+	"azurerm_automation_account":                                       "Microsoft.Automation/automationAccounts",
+	"azurerm_automation_certificate":                                   "Microsoft.Automation/automationAccounts/certificates",
+	"azurerm_automation_connection":                                    "Microsoft.Automation/automationAccounts/connections",
+	"azurerm_automation_connection_certificate":                        "Microsoft.Automation/automationAccounts/connections",
+	"azurerm_automation_connection_classic_certificate":                "Microsoft.Automation/automationAccounts/connections",
+	"azurerm_automation_connection_service_principal":                  "Microsoft.Automation/automationAccounts/connections",
+	"azurerm_automation_credential":                                    "Microsoft.Automation/automationAccounts/credentials",
+	"azurerm_automation_dsc_configuration":                             "Microsoft.Automation/automationAccounts/configurations",
+	"azurerm_automation_dsc_nodeconfiguration":                         "Microsoft.Automation/automationAccounts/nodeConfigurations",
+	"azurerm_automation_job_schedule":                                  "Microsoft.Automation/automationAccounts/jobSchedules",
+	"azurerm_automation_module":                                        "Microsoft.Automation/automationAccounts/modules",
+	"azurerm_automation_runbook":                                       "Microsoft.Automation/automationAccounts/runbooks",
+	"azurerm_automation_schedule":                                      "Microsoft.Automation/automationAccounts/schedules",
+	"azurerm_availability_set":                                         "Microsoft.Compute/availabilitySets",
+	"azurerm_backup_container_storage_account":                         "Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers",
+	"azurerm_bastion_host":                                             "Microsoft.Network/bastionHosts",
+	"azurerm_batch_account":                                            "Microsoft.Batch/batchAccounts",
+	"azurerm_batch_pool":                                               "Microsoft.Batch/batchAccounts/pools",
+	"azurerm_cdn_endpoint":                                             "Microsoft.Cdn/profiles/endpoints",
+	"azurerm_cdn_profile":                                              "Microsoft.Cdn/profiles",
+	"azurerm_cognitive_account":                                        "Microsoft.CognitiveServices/accounts",
+	"azurerm_container_group":                                          "Microsoft.ContainerInstance/containerGroups",
+	"azurerm_container_registry":                                       "Microsoft.ContainerRegistry/registries",
+	"azurerm_container_registry_webhook":                               "Microsoft.ContainerRegistry/registries/webhooks",
+	"azurerm_cosmosdb_account":                                         "Microsoft.DocumentDB/databaseAccounts",
+	"azurerm_cosmosdb_cassandra_keyspace":                              "Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces",
+	"azurerm_cosmosdb_cassandra_table":                                 "Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces/tables",
+	"azurerm_cosmosdb_gremlin_database":                                "Microsoft.DocumentDB/databaseAccounts/gremlinDatabases",
+	"azurerm_cosmosdb_gremlin_graph":                                   "Microsoft.DocumentDB/databaseAccounts/gremlinDatabases/graphs",
+	"azurerm_cosmosdb_mongo_collection":                                "Microsoft.DocumentDB/databaseAccounts/mongodbDatabases/collections",
+	"azurerm_cosmosdb_mongo_database":                                  "Microsoft.DocumentDB/databaseAccounts/mongodbDatabases",
+	"azurerm_cosmosdb_sql_container":                                   "Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers",
+	"azurerm_cosmosdb_sql_database":                                    "Microsoft.DocumentDB/databaseAccounts/sqlDatabases",
+	"azurerm_cosmosdb_sql_stored_procedure":                            "Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/storedProcedures",
+	"azurerm_cosmosdb_table":                                           "Microsoft.DocumentDB/databaseAccounts/tables",
+	"azurerm_cost_management_export_resource_group":                    "Microsoft.CostManagement/exports",
+	"azurerm_custom_ip_prefix":                                         "Microsoft.Network/customIpPrefixes",
+	"azurerm_custom_provider":                                          "Microsoft.CustomProviders/resourceProviders",
+	"azurerm_dashboard":                                                "Microsoft.Portal/dashboards",
+	"azurerm_data_factory":                                             "Microsoft.DataFactory/factories",
+	"azurerm_data_factory_dataset_azure_blob":                          "Microsoft.DataFactory/factories/datasets",
+	"azurerm_data_factory_dataset_http":                                "Microsoft.DataFactory/factories/datasets",
+	"azurerm_data_factory_dataset_mysql":                               "Microsoft.DataFactory/factories/datasets",
+	"azurerm_data_factory_dataset_postgresql":                          "Microsoft.DataFactory/factories/datasets",
+	"azurerm_data_factory_dataset_sql_server_table":                    "Microsoft.DataFactory/factories/datasets",
+	"azurerm_data_factory_integration_runtime_azure":                   "Microsoft.DataFactory/factories/integrationruntimes",
+	"azurerm_data_factory_integration_runtime_azure_ssis":              "Microsoft.DataFactory/factories/integrationruntimes",
+	"azurerm_data_factory_integration_runtime_managed":                 "Microsoft.DataFactory/factories/integrationruntimes",
+	"azurerm_data_factory_integration_runtime_self_hosted":             "Microsoft.DataFactory/factories/integrationruntimes",
+	"azurerm_data_factory_linked_service_azure_blob_storage":           "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_azure_file_storage":           "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_azure_function":               "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_azure_sql_database":           "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_azure_table_storage":          "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_cosmosdb":                     "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_data_lake_storage_gen2":       "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_key_vault":                    "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_mysql":                        "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_postgresql":                   "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_sftp":                         "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_snowflake":                    "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_sql_server":                   "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_synapse":                      "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_linked_service_web":                          "Microsoft.DataFactory/factories/linkedservices",
+	"azurerm_data_factory_pipeline":                                    "Microsoft.DataFactory/factories/pipelines",
+	"azurerm_data_factory_schedule_trigger":                            "Microsoft.DataFactory/factories/triggers",
+	"azurerm_data_lake_analytics_account":                              "Microsoft.DataLakeAnalytics/accounts",
+	"azurerm_data_lake_analytics_firewall_rule":                        "Microsoft.DataLakeAnalytics/accounts/firewallRules",
+	"azurerm_data_lake_store":                                          "Microsoft.DataLakeStore/accounts",
+	"azurerm_data_lake_store_firewall_rule":                            "Microsoft.DataLakeStore/accounts/firewallRules",
+	"azurerm_data_share":                                               "Microsoft.DataShare/accounts/shares",
+	"azurerm_data_share_account":                                       "Microsoft.DataShare/accounts",
+	"azurerm_data_share_dataset_blob_storage":                          "Microsoft.DataShare/accounts/shares/dataSets",
+	"azurerm_data_share_dataset_data_lake_gen1":                        "Microsoft.DataShare/accounts/shares/dataSets",
+	"azurerm_data_share_dataset_data_lake_gen2":                        "Microsoft.DataShare/accounts/shares/dataSets",
+	"azurerm_data_share_dataset_kusto_cluster":                         "Microsoft.DataShare/accounts/shares/dataSets",
+	"azurerm_data_share_dataset_kusto_database":                        "Microsoft.DataShare/accounts/shares/dataSets",
+	"azurerm_databox_edge_device":                                      "Microsoft.DataBoxEdge/dataBoxEdgeDevices",
+	"azurerm_databoxedge_order":                                        "Microsoft.DataBoxEdge/dataBoxEdgeDevices/orders",
+	"azurerm_databricks_workspace":                                     "Microsoft.Databricks/workspaces",
+	"azurerm_dedicated_hardware_security_module":                       "Microsoft.HardwareSecurityModules/dedicatedHSMs",
+	"azurerm_dev_test_global_vm_shutdown_schedule":                     "Microsoft.DevTestLab/schedules",
+	"azurerm_dev_test_lab":                                             "Microsoft.DevTestLab/labs",
+	"azurerm_dev_test_linux_virtual_machine":                           "Microsoft.DevTestLab/labs/virtualmachines",
+	"azurerm_dev_test_policy":                                          "Microsoft.DevTestLab/labs/policysets/policies",
+	"azurerm_dev_test_schedule":                                        "Microsoft.DevTestLab/labs/schedules",
+	"azurerm_dev_test_virtual_network":                                 "Microsoft.DevTestLab/labs/virtualnetworks",
+	"azurerm_dev_test_windows_virtual_machine":                         "Microsoft.DevTestLab/labs/virtualmachines",
+	"azurerm_devspace_controller":                                      "Microsoft.DevSpaces/controllers",
+	"azurerm_digital_twins_endpoint_eventgrid":                         "Microsoft.DigitalTwins/digitalTwinsInstances/endpoints",
+	"azurerm_digital_twins_endpoint_eventhub":                          "Microsoft.DigitalTwins/digitalTwinsInstances/endpoints",
+	"azurerm_digital_twins_endpoint_servicebus":                        "Microsoft.DigitalTwins/digitalTwinsInstances/endpoints",
+	"azurerm_digital_twins_instance":                                   "Microsoft.DigitalTwins/digitalTwinsInstances",
+	"azurerm_disk_access":                                              "Microsoft.Compute/diskAccesses",
+	"azurerm_disk_encryption_set":                                      "Microsoft.Compute/diskEncryptionSets",
+	"azurerm_dns_a_record":                                             "Microsoft.Network/dnszones/A",
+	"azurerm_dns_aaaa_record":                                          "Microsoft.Network/dnszones/AAAA",
+	"azurerm_dns_caa_record":                                           "Microsoft.Network/dnszones/CAA",
+	"azurerm_dns_cname_record":                                         "Microsoft.Network/dnszones/CNAME",
+	"azurerm_dns_mx_record":                                            "Microsoft.Network/dnszones/MX",
+	"azurerm_dns_ns_record":                                            "Microsoft.Network/dnszones/NS",
+	"azurerm_dns_ptr_record":                                           "Microsoft.Network/dnszones/PTR",
+	"azurerm_dns_srv_record":                                           "Microsoft.Network/dnszones/SRV",
+	"azurerm_dns_txt_record":                                           "Microsoft.Network/dnszones/TXT",
+	"azurerm_dns_zone":                                                 "Microsoft.Network/dnszones",
+	"azurerm_eventgrid_domain":                                         "Microsoft.EventGrid/domains",
+	"azurerm_eventgrid_domain_topic":                                   "Microsoft.EventGrid/domains/topics",
+	"azurerm_eventgrid_system_topic":                                   "Microsoft.EventGrid/systemTopics",
+	"azurerm_eventgrid_topic":                                          "Microsoft.EventGrid/topics",
+	"azurerm_eventhub":                                                 "Microsoft.EventHub/namespaces/eventhubs",
+	"azurerm_eventhub_authorization_rule":                              "Microsoft.EventHub/namespaces/eventhubs/authorizationRules",
+	"azurerm_eventhub_cluster":                                         "Microsoft.EventHub/clusters",
+	"azurerm_eventhub_consumer_group":                                  "Microsoft.EventHub/namespaces/eventhubs/consumergroups",
+	"azurerm_eventhub_namespace":                                       "Microsoft.EventHub/namespaces",
+	"azurerm_eventhub_namespace_disaster_recovery_config":              "Microsoft.EventHub/namespaces/disasterRecoveryConfigs",
+	"azurerm_express_route_circuit":                                    "Microsoft.Network/expressRouteCircuits",
+	"azurerm_express_route_circuit_authorization":                      "Microsoft.Network/expressRouteCircuits/authorizations",
+	"azurerm_express_route_circuit_connection":                         "Microsoft.Network/expressRouteCircuits/peerings/connections",
+	"azurerm_express_route_circuit_peering":                            "Microsoft.Network/expressRouteCircuits/peerings",
+	"azurerm_express_route_gateway":                                    "Microsoft.Network/expressRouteGateways",
+	"azurerm_express_route_port":                                       "Microsoft.Network/expressRoutePorts",
+	"azurerm_firewall":                                                 "Microsoft.Network/azureFirewalls",
+	"azurerm_firewall_application_rule_collection":                     "Microsoft.Network/azureFirewalls",
+	"azurerm_firewall_nat_rule_collection":                             "Microsoft.Network/azureFirewalls",
+	"azurerm_firewall_network_rule_collection":                         "Microsoft.Network/azureFirewalls",
+	"azurerm_firewall_policy":                                          "Microsoft.Network/firewallPolicies",
+	"azurerm_firewall_policy_rule_collection_group":                    "Microsoft.Network/firewallPolicies/ruleCollectionGroups",
+	"azurerm_frontdoor":                                                "Microsoft.Network/frontDoors",
+	"azurerm_frontdoor_custom_https_configuration":                     "Microsoft.Network/frontDoors/frontendEndpoints",
+	"azurerm_function_app":                                             "Microsoft.Web/sites",
+	"azurerm_hdinsight_hadoop_cluster":                                 "Microsoft.HDInsight/clusters",
+	"azurerm_hdinsight_hbase_cluster":                                  "Microsoft.HDInsight/clusters",
+	"azurerm_hdinsight_interactive_query_cluster":                      "Microsoft.HDInsight/clusters",
+	"azurerm_hdinsight_kafka_cluster":                                  "Microsoft.HDInsight/clusters",
+	"azurerm_hdinsight_ml_services_cluster":                            "Microsoft.HDInsight/clusters",
+	"azurerm_hdinsight_rserver_cluster":                                "Microsoft.HDInsight/clusters",
+	"azurerm_hdinsight_spark_cluster":                                  "Microsoft.HDInsight/clusters",
+	"azurerm_hdinsight_storm_cluster":                                  "Microsoft.HDInsight/clusters",
+	"azurerm_healthcare_service":                                       "Microsoft.HealthcareApis/services",
+	"azurerm_hpc_cache":                                                "Microsoft.StorageCache/caches",
+	"azurerm_hpc_cache_access_policy":                                  "Microsoft.StorageCache/caches/cacheAccessPolicies",
+	"azurerm_hpc_cache_blob_target":                                    "Microsoft.StorageCache/caches/storageTargets",
+	"azurerm_image":                                                    "microsoft.compute/images",
+	"azurerm_iot_security_device_group":                                "Microsoft.Security/deviceSecurityGroups",
+	"azurerm_iot_security_solution":                                    "Microsoft.Security/IoTSecuritySolutions",
+	"azurerm_iot_time_series_access_policy":                            "Microsoft.TimeSeriesInsights/environments/accessPolicies",
+	"azurerm_iot_time_series_environment":                              "Microsoft.TimeSeriesInsights/environments",
+	"azurerm_iot_time_series_insights_gen2_environment":                "Microsoft.TimeSeriesInsights/environments",
+	"azurerm_iot_time_series_insights_reference_data_set":              "Microsoft.TimeSeriesInsights/environments/referenceDataSets",
+	"azurerm_iotcentral_application":                                   "Microsoft.IoTCentral/IoTApps",
+	"azurerm_iothub":                                                   "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_consumer_group":                                    "Microsoft.Devices/IotHubs/eventHubEndpoints/ConsumerGroups",
+	"azurerm_iothub_dps":                                               "Microsoft.Devices/provisioningServices",
+	"azurerm_iothub_dps_certificate":                                   "Microsoft.Devices/provisioningServices/certificates",
+	"azurerm_iothub_dps_shared_access_policy":                          "Microsoft.Devices/provisioningServices",
+	"azurerm_iothub_endpoint_eventhub":                                 "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_endpoint_servicebus_queue":                         "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_endpoint_servicebus_topic":                         "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_endpoint_storage_container":                        "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_enrichment":                                        "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_route":                                             "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_shared_access_policy":                              "Microsoft.Devices/IotHubs",
+	"azurerm_ip_group":                                                 "Microsoft.Network/ipGroups",
+	"azurerm_key_vault":                                                "Microsoft.KeyVault/vaults",
+	"azurerm_key_vault_access_policy":                                  "Microsoft.KeyVault/vaults/accessPolicies",
+	"azurerm_key_vault_managed_hardware_security_module":               "Microsoft.KeyVault/managedHSMs",
+	"azurerm_kubernetes_cluster":                                       "Microsoft.ContainerService/managedClusters",
+	"azurerm_kubernetes_cluster_node_pool":                             "Microsoft.ContainerService/managedClusters/agentPools",
+	"azurerm_kusto_attached_database_configuration":                    "Microsoft.Kusto/Clusters/AttachedDatabaseConfigurations",
+	"azurerm_kusto_cluster":                                            "Microsoft.Kusto/Clusters",
+	"azurerm_kusto_cluster_customer_managed_key":                       "Microsoft.Kusto/Clusters",
+	"azurerm_kusto_cluster_principal_assignment":                       "Microsoft.Kusto/Clusters/PrincipalAssignments",
+	"azurerm_kusto_database":                                           "Microsoft.Kusto/Clusters/Databases",
+	"azurerm_kusto_database_principal":                                 "Microsoft.Kusto/Clusters/Databases",
+	"azurerm_kusto_database_principal_assignment":                      "Microsoft.Kusto/Clusters/Databases/PrincipalAssignments",
+	"azurerm_kusto_eventgrid_data_connection":                          "Microsoft.Kusto/Clusters/Databases/DataConnections",
+	"azurerm_kusto_eventhub_data_connection":                           "Microsoft.Kusto/Clusters/Databases/DataConnections",
+	"azurerm_kusto_iothub_data_connection":                             "Microsoft.Kusto/Clusters/Databases/DataConnections",
+	"azurerm_lb":                                                       "Microsoft.Network/loadBalancers",
+	"azurerm_lb_backend_address_pool":                                  "Microsoft.Network/loadBalancers/backendAddressPools",
+	"azurerm_lb_backend_address_pool_address":                          "Microsoft.Network/loadBalancers/backendAddressPools",
+	"azurerm_lb_nat_pool":                                              "Microsoft.Network/loadBalancers/inboundNatPools",
+	"azurerm_lb_nat_rule":                                              "Microsoft.Network/loadBalancers/inboundNatRules",
+	"azurerm_lb_outbound_rule":                                         "Microsoft.Network/loadBalancers/outboundRules",
+	"azurerm_lb_probe":                                                 "Microsoft.Network/loadBalancers/probes",
+	"azurerm_lb_rule":                                                  "Microsoft.Network/loadBalancers/loadBalancingRules",
+	"azurerm_linux_virtual_machine":                                    "Microsoft.Compute/virtualMachines",
+	"azurerm_local_network_gateway":                                    "Microsoft.Network/localNetworkGateways",
+	"azurerm_log_analytics_cluster":                                    "Microsoft.OperationalInsights/clusters",
+	"azurerm_log_analytics_data_export_rule":                           "Microsoft.OperationalInsights/workspaces/dataExports",
+	"azurerm_log_analytics_datasource_windows_event":                   "Microsoft.OperationalInsights/workspaces/datasources",
+	"azurerm_log_analytics_datasource_windows_performance_counter":     "Microsoft.OperationalInsights/workspaces/datasources",
+	"azurerm_log_analytics_linked_service":                             "Microsoft.OperationalInsights/workspaces/linkedServices",
+	"azurerm_log_analytics_linked_storage_account":                     "Microsoft.OperationalInsights/workspaces/linkedStorageAccounts",
+	"azurerm_log_analytics_saved_search":                               "Microsoft.OperationalInsights/workspaces/savedSearches",
+	"azurerm_log_analytics_solution":                                   "Microsoft.OperationsManagement/solutions",
+	"azurerm_log_analytics_storage_insights":                           "Microsoft.OperationalInsights/workspaces/storageInsightConfigs",
+	"azurerm_log_analytics_workspace":                                  "Microsoft.OperationalInsights/workspaces",
+	"azurerm_logic_app_action_custom":                                  "Microsoft.Logic/workflows",
+	"azurerm_logic_app_action_http":                                    "Microsoft.Logic/workflows",
+	"azurerm_logic_app_integration_account":                            "Microsoft.Logic/integrationAccounts",
+	"azurerm_logic_app_trigger_custom":                                 "Microsoft.Logic/workflows",
+	"azurerm_logic_app_trigger_http_request":                           "Microsoft.Logic/workflows",
+	"azurerm_logic_app_trigger_recurrence":                             "Microsoft.Logic/workflows",
+	"azurerm_logic_app_workflow":                                       "Microsoft.Logic/workflows",
+	"azurerm_machine_learning_workspace":                               "Microsoft.MachineLearningServices/workspaces",
+	"azurerm_maintenance_assignment_dedicated_host":                    "Microsoft.Maintenance/configurationAssignments",
+	"azurerm_maintenance_assignment_virtual_machine":                   "Microsoft.Maintenance/configurationAssignments",
+	"azurerm_maintenance_configuration":                                "microsoft.maintenance/maintenanceconfigurations",
+	"azurerm_managed_disk":                                             "microsoft.compute/disks",
+	"azurerm_management_lock":                                          "Microsoft.Authorization/locks",
+	"azurerm_maps_account":                                             "Microsoft.Maps/accounts",
+	"azurerm_mariadb_configuration":                                    "Microsoft.DBforMariaDB/servers/configurations",
+	"azurerm_mariadb_database":                                         "Microsoft.DBforMariaDB/servers/databases",
+	"azurerm_mariadb_firewall_rule":                                    "Microsoft.DBforMariaDB/servers/firewallRules",
+	"azurerm_mariadb_server":                                           "Microsoft.DBforMariaDB/servers",
+	"azurerm_media_asset":                                              "Microsoft.Media/mediaservices/assets",
+	"azurerm_media_content_key_policy":                                 "Microsoft.Media/mediaservices/contentkeypolicies",
+	"azurerm_media_job":                                                "Microsoft.Media/mediaservices/transforms/jobs",
+	"azurerm_media_live_event":                                         "Microsoft.Media/mediaservices/liveevents",
+	"azurerm_media_services_account":                                   "Microsoft.Media/mediaservices",
+	"azurerm_media_streaming_locator":                                  "Microsoft.Media/mediaservices/streaminglocators",
+	"azurerm_media_streaming_policy":                                   "Microsoft.Media/mediaservices/streamingpolicies",
+	"azurerm_media_transform":                                          "Microsoft.Media/mediaservices/transforms",
+	"azurerm_monitor_action_group":                                     "Microsoft.Insights/actionGroups",
+	"azurerm_monitor_activity_log_alert":                               "microsoft.insights/activityLogAlerts",
+	"azurerm_monitor_autoscale_setting":                                "microsoft.insights/autoscalesettings",
+	"azurerm_monitor_metric_alert":                                     "microsoft.insights/metricAlerts",
+	"azurerm_monitor_scheduled_query_rules_alert":                      "Microsoft.Insights/scheduledQueryRules",
+	"azurerm_monitor_scheduled_query_rules_log":                        "Microsoft.Insights/scheduledQueryRules",
+	"azurerm_mssql_database":                                           "Microsoft.Sql/servers/databases",
+	"azurerm_mssql_database_extended_auditing_policy":                  "Microsoft.Sql/servers/databases/extendedAuditingSettings",
+	"azurerm_mssql_elasticpool":                                        "Microsoft.Sql/servers/elasticPools",
+	"azurerm_mssql_firewall_rule":                                      "Microsoft.Sql/servers/firewallRules",
+	"azurerm_mssql_server":                                             "Microsoft.Sql/servers",
+	"azurerm_mssql_server_extended_auditing_policy":                    "Microsoft.Sql/servers/extendedAuditingSettings",
+	"azurerm_mssql_server_vulnerability_assessment":                    "Microsoft.Sql/servers/vulnerabilityAssessments",
+	"azurerm_mssql_virtual_network_rule":                               "Microsoft.Sql/servers/virtualNetworkRules",
+	"azurerm_mysql_active_directory_administrator":                     "Microsoft.DBforMySQL/servers/administrators",
+	"azurerm_mysql_configuration":                                      "Microsoft.DBforMySQL/servers/configurations",
+	"azurerm_mysql_database":                                           "Microsoft.DBforMySQL/servers/databases",
+	"azurerm_mysql_firewall_rule":                                      "Microsoft.DBforMySQL/servers/firewallRules",
+	"azurerm_mysql_server":                                             "Microsoft.DBforMySQL/servers",
+	"azurerm_mysql_server_key":                                         "Microsoft.DBforMySQL/servers/keys",
+	"azurerm_mysql_virtual_network_rule":                               "Microsoft.DBforMySQL/servers/virtualNetworkRules",
+	"azurerm_nat_gateway":                                              "Microsoft.Network/natGateways",
+	"azurerm_network_connection_monitor":                               "Microsoft.Network/networkWatchers/connectionMonitors",
+	"azurerm_network_ddos_custom_policy":                               "Microsoft.Network/ddosCustomPolicies",
+	"azurerm_network_ddos_protection_plan":                             "Microsoft.Network/ddosProtectionPlans",
+	"azurerm_network_interface":                                        "Microsoft.Network/networkInterfaces",
+	"azurerm_network_interface_virtual_network_tap_association":        "Microsoft.Network/networkInterfaces/tapConfigurations",
+	"azurerm_network_packet_capture":                                   "Microsoft.Network/networkWatchers/packetCaptures",
+	"azurerm_network_profile":                                          "Microsoft.Network/networkProfiles",
+	"azurerm_network_security_group":                                   "Microsoft.Network/networkSecurityGroups",
+	"azurerm_network_security_rule":                                    "Microsoft.Network/networkSecurityGroups/securityRules",
+	"azurerm_network_watcher":                                          "Microsoft.Network/networkWatchers",
+	"azurerm_notification_hub":                                         "Microsoft.NotificationHubs/namespaces/notificationHubs",
+	"azurerm_notification_hub_authorization_rule":                      "Microsoft.NotificationHubs/namespaces/notificationHubs/AuthorizationRules",
+	"azurerm_notification_hub_namespace":                               "Microsoft.NotificationHubs/namespaces",
+	"azurerm_packet_capture":                                           "Microsoft.Network/networkWatchers/packetCaptures",
+	"azurerm_point_to_site_vpn_gateway":                                "Microsoft.Network/p2svpnGateways",
+	"azurerm_policy_remediation":                                       "Microsoft.PolicyInsights/remediations",
+	"azurerm_postgresql_active_directory_administrator":                "Microsoft.DBforPostgreSQL/servers/administrators",
+	"azurerm_postgresql_configuration":                                 "Microsoft.DBforPostgreSQL/servers/configurations",
+	"azurerm_postgresql_database":                                      "Microsoft.DBforPostgreSQL/servers/databases",
+	"azurerm_postgresql_firewall_rule":                                 "Microsoft.DBforPostgreSQL/servers/firewallRules",
+	"azurerm_postgresql_server":                                        "Microsoft.DBforPostgreSQL/servers",
+	"azurerm_postgresql_server_key":                                    "Microsoft.DBforPostgreSQL/servers/keys",
+	"azurerm_postgresql_virtual_network_rule":                          "Microsoft.DBforPostgreSQL/servers/virtualNetworkRules",
+	"azurerm_private_dns_a_record":                                     "Microsoft.Network/privateDnsZones/A",
+	"azurerm_private_dns_cname_record":                                 "Microsoft.Network/privateDnsZones/CName",
+	"azurerm_private_dns_ptr_record":                                   "Microsoft.Network/privateDnsZones/PTR",
+	"azurerm_private_dns_srv_record":                                   "Microsoft.Network/privateDnsZones/SRV",
+	"azurerm_private_dns_txt_record":                                   "Microsoft.Network/privateDnsZones/TXT",
+	"azurerm_private_dns_zone":                                         "Microsoft.Network/privateDnsZones",
+	"azurerm_private_dns_zone_virtual_network_link":                    "Microsoft.Network/privateDnsZones/virtualNetworkLinks",
+	"azurerm_proximity_placement_group":                                "Microsoft.Compute/proximityPlacementGroups",
+	"azurerm_public_ip":                                                "Microsoft.Network/publicIPAddresses",
+	"azurerm_public_ip_prefix":                                         "Microsoft.Network/publicIPPrefixes",
+	"azurerm_purview_account":                                          "Microsoft.Purview/accounts",
+	"azurerm_redis_cache":                                              "Microsoft.Cache/Redis",
+	"azurerm_redis_firewall_rule":                                      "Microsoft.Cache/Redis/firewallRules",
+	"azurerm_redis_linked_server":                                      "Microsoft.Cache/Redis/linkedServers",
+	"azurerm_redisenterprise_database":                                 "Microsoft.Cache/redisEnterprise/databases",
+	"azurerm_redisenterprise_redis_enterprise":                         "Microsoft.Cache/redisEnterprise",
+	"azurerm_relay_hybrid_connection":                                  "Microsoft.Relay/namespaces/hybridConnections",
+	"azurerm_relay_namespace":                                          "Microsoft.Relay/namespaces",
+	"azurerm_resource_group":                                           "Microsoft.Resources/subscriptions/resourceGroups",
+	"azurerm_resource_group_template_deployment":                       "Microsoft.Resources/deployments",
+	"azurerm_route":                                                    "Microsoft.Network/routeTables/routes",
+	"azurerm_route_filter":                                             "Microsoft.Network/routeFilters",
+	"azurerm_route_table":                                              "Microsoft.Network/routeTables",
+	"azurerm_search_service":                                           "Microsoft.Search/searchServices",
+	"azurerm_security_center_assessment":                               "Microsoft.Security/assessments",
+	"azurerm_security_center_automation":                               "Microsoft.Security/automations",
+	"azurerm_security_center_server_vulnerability_assessment":          "Microsoft.Security/serverVulnerabilityAssessments",
+	"azurerm_sentinel_alert_rule_fusion":                               "Microsoft.SecurityInsights/alertRules",
+	"azurerm_sentinel_alert_rule_ms_security_incident":                 "Microsoft.SecurityInsights/alertRules",
+	"azurerm_sentinel_alert_rule_scheduled":                            "Microsoft.SecurityInsights/alertRules",
+	"azurerm_sentinel_data_connector_aws_cloud_trail":                  "Microsoft.SecurityInsights/dataConnectors",
+	"azurerm_sentinel_data_connector_azure_active_directory":           "Microsoft.SecurityInsights/dataConnectors",
+	"azurerm_sentinel_data_connector_azure_advanced_threat_protection": "Microsoft.SecurityInsights/dataConnectors",
+	"azurerm_sentinel_data_connector_azure_security_center":            "Microsoft.SecurityInsights/dataConnectors",
+	"azurerm_sentinel_data_connector_microsoft_cloud_app_security":     "Microsoft.SecurityInsights/dataConnectors",
+	"azurerm_sentinel_data_connector_office_365":                       "Microsoft.SecurityInsights/dataConnectors",
+	"azurerm_sentinel_data_connector_threat_intelligence":              "Microsoft.SecurityInsights/dataConnectors",
+	"azurerm_service_endpoint_policy":                                  "Microsoft.Network/serviceEndpointPolicies",
+	"azurerm_service_fabric_cluster":                                   "Microsoft.ServiceFabric/clusters",
+	"azurerm_service_fabric_mesh_application":                          "Microsoft.ServiceFabricMesh/applications",
+	"azurerm_service_fabric_mesh_local_network":                        "Microsoft.ServiceFabricMesh/networks",
+	"azurerm_service_fabric_mesh_secret":                               "Microsoft.ServiceFabricMesh/secrets",
+	"azurerm_service_fabric_mesh_secret_value":                         "Microsoft.ServiceFabricMesh/secrets/values",
+	"azurerm_servicebus_namespace":                                     "microsoft.servicebus/namespaces",
+	"azurerm_servicebus_namespace_authorization_rule":                  "Microsoft.ServiceBus/namespaces/AuthorizationRules",
+	"azurerm_servicebus_namespace_network_rule_set":                    "Microsoft.Servicebus/namespaces/networkrulesets",
+	"azurerm_servicebus_queue":                                         "microsoft.servicebus/namespaces/queues",
+	"azurerm_servicebus_queue_authorization_rule":                      "Microsoft.ServiceBus/namespaces/queues/authorizationRules",
+	"azurerm_servicebus_subscription":                                  "microsoft.servicebus/namespaces/topics/subscriptions",
+	"azurerm_servicebus_subscription_rule":                             "microsoft.servicebus/namespaces/topics/subscriptions/rules",
+	"azurerm_servicebus_topic":                                         "microsoft.servicebus/namespaces/topics",
+	"azurerm_servicebus_topic_authorization_rule":                      "Microsoft.ServiceBus/namespaces/topics/authorizationRules",
+	"azurerm_shared_image":                                             "Microsoft.Compute/galleries/images",
+	"azurerm_shared_image_gallery":                                     "Microsoft.Compute/galleries",
+	"azurerm_shared_image_version":                                     "Microsoft.Compute/galleries/images/versions",
+	"azurerm_signalr_service":                                          "Microsoft.SignalRService/SignalR",
+	"azurerm_site_recovery_fabric":                                     "Microsoft.RecoveryServices/vaults/replicationFabrics",
+	"azurerm_site_recovery_network_mapping":                            "Microsoft.RecoveryServices/vaults/replicationFabrics/replicationNetworks/replicationNetworkMappings",
+	"azurerm_site_recovery_protection_container":                       "Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers",
+	"azurerm_site_recovery_protection_container_mapping":               "Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings",
+	"azurerm_site_recovery_replicated_vm":                              "Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectedItems",
+	"azurerm_site_recovery_replication_policy":                         "Microsoft.RecoveryServices/vaults/replicationPolicies",
+	"azurerm_snapshot":                                                 "Microsoft.Compute/snapshots",
+	"azurerm_spatial_anchors_account":                                  "Microsoft.MixedReality/spatialAnchorsAccounts",
+	"azurerm_spring_cloud_active_deployment":                           "Microsoft.AppPlatform/Spring/apps",
+	"azurerm_spring_cloud_app":                                         "Microsoft.AppPlatform/Spring/apps",
+	"azurerm_spring_cloud_certificate":                                 "Microsoft.AppPlatform/Spring/certificates",
+	"azurerm_spring_cloud_custom_domain":                               "Microsoft.AppPlatform/Spring/apps/domains",
+	"azurerm_spring_cloud_java_deployment":                             "Microsoft.AppPlatform/Spring/apps/deployments",
+	"azurerm_spring_cloud_service":                                     "Microsoft.AppPlatform/Spring",
+	"azurerm_sql_database":                                             "Microsoft.Sql/servers/databases",
+	"azurerm_sql_elasticpool":                                          "Microsoft.Sql/servers/elasticPools",
+	"azurerm_sql_failover_group":                                       "Microsoft.Sql/servers/failovergroups",
+	"azurerm_sql_firewall_rule":                                        "Microsoft.Sql/servers/firewallRules",
+	"azurerm_sql_server":                                               "Microsoft.Sql/servers",
+	"azurerm_sql_virtual_network_rule":                                 "Microsoft.Sql/servers/virtualNetworkRules",
+	"azurerm_ssh_public_key":                                           "Microsoft.Compute/SshPublicKeys",
+	"azurerm_stack_hci_cluster":                                        "Microsoft.AzureStackHCI/clusters",
+	"azurerm_storage_account":                                          "Microsoft.Storage/storageAccounts",
+	"azurerm_storage_account_customer_managed_key":                     "Microsoft.Storage/storageAccounts",
+	"azurerm_storage_account_network_rules":                            "Microsoft.Storage/storageAccounts",
+	"azurerm_storage_encryption_scope":                                 "Microsoft.Storage/storageAccounts/encryptionScopes",
+	"azurerm_storage_management_policy":                                "Microsoft.Storage/storageAccounts/managementPolicies",
+	"azurerm_storage_object_replication":                               "Microsoft.Storage/storageAccounts/objectReplicationPolicies",
+	"azurerm_storage_sync":                                             "Microsoft.StorageSync/storageSyncServices",
+	"azurerm_storage_sync_cloud_endpoint":                              "Microsoft.StorageSync/storageSyncServices/syncGroups/cloudEndpoints",
+	"azurerm_storage_sync_group":                                       "Microsoft.StorageSync/storageSyncServices/syncGroups",
+	"azurerm_stream_analytics_job":                                     "Microsoft.StreamAnalytics/streamingjobs",
+	"azurerm_stream_analytics_output_blob":                             "Microsoft.StreamAnalytics/streamingjobs/outputs",
+	"azurerm_stream_analytics_output_eventhub":                         "Microsoft.StreamAnalytics/streamingjobs/outputs",
+	"azurerm_stream_analytics_output_mssql":                            "Microsoft.StreamAnalytics/streamingjobs/outputs",
+	"azurerm_stream_analytics_output_servicebus_queue":                 "Microsoft.StreamAnalytics/streamingjobs/outputs",
+	"azurerm_stream_analytics_output_servicebus_topic":                 "Microsoft.StreamAnalytics/streamingjobs/outputs",
+	"azurerm_stream_analytics_reference_input_blob":                    "Microsoft.StreamAnalytics/streamingjobs/inputs",
+	"azurerm_stream_analytics_stream_input_blob":                       "Microsoft.StreamAnalytics/streamingjobs/inputs",
+	"azurerm_stream_analytics_stream_input_eventhub":                   "Microsoft.StreamAnalytics/streamingjobs/inputs",
+	"azurerm_stream_analytics_stream_input_iothub":                     "Microsoft.StreamAnalytics/streamingjobs/inputs",
+	"azurerm_subnet":                                                   "Microsoft.Network/virtualNetworks/subnets",
+	"azurerm_subnet_nat_gateway_association":                           "Microsoft.Network/virtualNetworks/subnets",
+	"azurerm_subnet_network_security_group_association":                "Microsoft.Network/virtualNetworks/subnets",
+	"azurerm_subnet_route_table_association":                           "Microsoft.Network/virtualNetworks/subnets",
+	"azurerm_synapse_firewall_rule":                                    "Microsoft.Synapse/workspaces/firewallRules",
+	"azurerm_synapse_managed_private_endpoint":                         "Microsoft.Synapse/workspaces/managedVirtualNetworks/managedPrivateEndpoints",
+	"azurerm_synapse_spark_pool":                                       "Microsoft.Synapse/workspaces/bigDataPools",
+	"azurerm_synapse_sql_pool":                                         "Microsoft.Synapse/workspaces/sqlPools",
+	"azurerm_synapse_workspace":                                        "Microsoft.Synapse/workspaces",
+	"azurerm_traffic_manager_endpoint":                                 "Microsoft.Network/trafficManagerProfiles/azureEndpoints",
+	"azurerm_traffic_manager_profile":                                  "Microsoft.Network/trafficManagerProfiles",
+	"azurerm_virtual_desktop_application_group":                        "Microsoft.DesktopVirtualization/applicationGroups",
+	"azurerm_virtual_desktop_host_pool":                                "Microsoft.DesktopVirtualization/hostpools",
+	"azurerm_virtual_desktop_workspace":                                "Microsoft.DesktopVirtualization/workspaces",
+	"azurerm_virtual_hub_bgp_connection":                               "Microsoft.Network/virtualHubs/bgpConnections",
+	"azurerm_virtual_hub_ip":                                           "Microsoft.Network/virtualHubs/ipConfigurations",
+	"azurerm_virtual_hub_network_virtual_appliance":                    "Microsoft.Network/networkVirtualAppliances",
+	"azurerm_virtual_hub_network_virtual_appliance_site":               "Microsoft.Network/networkVirtualAppliances/virtualApplianceSites",
+	"azurerm_virtual_hub_route_table":                                  "Microsoft.Network/virtualHubs/hubRouteTables",
+	"azurerm_virtual_hub_route_table_v2":                               "Microsoft.Network/virtualHubs/routeTables",
+	"azurerm_virtual_hub_security_partner_provider":                    "Microsoft.Network/securityPartnerProviders",
+	"azurerm_virtual_machine":                                          "microsoft.compute/virtualMachines",
+	"azurerm_virtual_machine_data_disk_attachment":                     "Microsoft.Compute/virtualMachines",
+	"azurerm_virtual_machine_extension":                                "Microsoft.Compute/virtualMachines/extensions",
+	"azurerm_virtual_machine_scale_set":                                "Microsoft.Compute/virtualMachineScaleSets",
+	"azurerm_virtual_machine_scale_set_extension":                      "Microsoft.Compute/virtualMachineScaleSets/extensions",
+	"azurerm_virtual_network":                                          "Microsoft.Network/virtualNetworks",
+	"azurerm_virtual_network_gateway":                                  "Microsoft.Network/virtualNetworkGateways",
+	"azurerm_virtual_network_gateway_connection":                       "Microsoft.Network/connections",
+	"azurerm_virtual_network_peering":                                  "Microsoft.Network/virtualNetworks/virtualNetworkPeerings",
+	"azurerm_virtual_network_tap":                                      "Microsoft.Network/virtualNetworkTaps",
+	"azurerm_virtual_router":                                           "Microsoft.Network/virtualRouters",
+	"azurerm_virtual_router_peering":                                   "Microsoft.Network/virtualRouters/peerings",
+	"azurerm_virtual_wan":                                              "Microsoft.Network/virtualWans",
+	"azurerm_vmware_private_cloud":                                     "Microsoft.AVS/PrivateClouds",
+	"azurerm_vpn_gateway":                                              "Microsoft.Network/vpnGateways",
+	"azurerm_vpn_gateway_connection":                                   "Microsoft.Network/vpnGateways/vpnConnections",
+	"azurerm_vpn_server_configuration":                                 "Microsoft.Network/vpnServerConfigurations",
+	"azurerm_vpn_site":                                                 "Microsoft.Network/vpnSites",
+}
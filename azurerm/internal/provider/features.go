@@ -54,6 +54,20 @@ func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
 			},
 		},
 
+		"permission_checks": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
 		"template_deployment": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -167,6 +181,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["permission_checks"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			permissionChecksRaw := items[0].(map[string]interface{})
+			if v, ok := permissionChecksRaw["enabled"]; ok {
+				features.PermissionChecks.Enabled = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["template_deployment"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
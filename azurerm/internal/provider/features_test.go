@@ -25,6 +25,9 @@ func TestExpandFeatures(t *testing.T) {
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
+				PermissionChecks: features.PermissionChecksFeatures{
+					Enabled: false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -59,6 +62,11 @@ func TestExpandFeatures(t *testing.T) {
 							"relaxed_locking": true,
 						},
 					},
+					"permission_checks": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": true,
@@ -88,6 +96,9 @@ func TestExpandFeatures(t *testing.T) {
 				Network: features.NetworkFeatures{
 					RelaxedLocking: true,
 				},
+				PermissionChecks: features.PermissionChecksFeatures{
+					Enabled: true,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -115,6 +126,11 @@ func TestExpandFeatures(t *testing.T) {
 							"relaxed_locking": false,
 						},
 					},
+					"permission_checks": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": false,
@@ -149,6 +165,9 @@ func TestExpandFeatures(t *testing.T) {
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
+				PermissionChecks: features.PermissionChecksFeatures{
+					Enabled: false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: false,
 				},
@@ -570,3 +589,67 @@ func TestExpandFeaturesLogAnalyticsWorkspace(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesPermissionChecks(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"permission_checks": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				PermissionChecks: features.PermissionChecksFeatures{
+					Enabled: false,
+				},
+			},
+		},
+		{
+			Name: "Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"permission_checks": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PermissionChecks: features.PermissionChecksFeatures{
+					Enabled: true,
+				},
+			},
+		},
+		{
+			Name: "Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"permission_checks": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PermissionChecks: features.PermissionChecksFeatures{
+					Enabled: false,
+				},
+			},
+		},
+	}
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.PermissionChecks, testCase.Expected.PermissionChecks) {
+			t.Fatalf("Expected %+v but got %+v", result.PermissionChecks, testCase.Expected.PermissionChecks)
+		}
+	}
+}
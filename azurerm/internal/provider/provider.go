@@ -13,8 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/permissions"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -98,6 +100,16 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 		}
 	}
 
+	for k, v := range dataSources {
+		scopeTimeoutsToResourceType(k, v)
+	}
+
+	for k, v := range resources {
+		scopeTimeoutsToResourceType(k, v)
+		permissions.WrapCustomizeDiff(k, v)
+		registerResourceProvidersOnDemand(k, v)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -214,6 +226,8 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_timeouts": schemaDefaultTimeouts(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -222,6 +236,24 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"resource_provider_registrations": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATIONS", resourceproviders.RegistrationSetAll),
+				ValidateFunc: validation.StringInSlice(resourceproviders.PossibleRegistrationSets(), false),
+				Description:  "The set of Resource Providers which should be automatically registered for the Subscription. Possible values are `all`, `core` and `none`.",
+			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourceproviders.EnhancedValidate,
+				},
+				Description: "A list of Resource Providers which should be automatically registered for the Subscription, in addition to those in `resource_provider_registrations`.",
+			},
+
 			"storage_use_azuread": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -306,8 +338,14 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		}
 
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+		requiredResourceProviders, err := resourceProvidersToRegister(d)
+		if err != nil {
+			return nil, err
+		}
+
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
+			ResourceProvidersToRegister: requiredResourceProviders,
 			SkipProviderRegistration:    skipProviderRegistration,
 			TerraformVersion:            terraformVersion,
 			PartnerId:                   d.Get("partner_id").(string),
@@ -325,9 +363,14 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			return nil, err
 		}
 
-		client.StopContext = p.StopContext()
+		defaultTimeouts, err := expandDefaultTimeouts(d.Get("default_timeouts").([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+
+		client.StopContext = timeouts.WithProviderDefaults(p.StopContext(), defaultTimeouts)
 
-		if !skipProviderRegistration {
+		if !skipProviderRegistration && len(requiredResourceProviders) > 0 {
 			// List all the available providers and their registration state to avoid unnecessary
 			// requests. This also lets us check if the provider credentials are correct.
 			ctx := client.StopContext
@@ -339,7 +382,6 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			}
 
 			availableResourceProviders := providerList.Values()
-			requiredResourceProviders := resourceproviders.Required()
 
 			if err := resourceproviders.EnsureRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders); err != nil {
 				return nil, fmt.Errorf(resourceProviderRegistrationErrorFmt, err)
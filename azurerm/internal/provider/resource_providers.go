@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+)
+
+// resourceProvidersToRegister returns the Resource Providers which should be registered when the
+// Provider is configured, based on the Registration Set and any explicitly specified Resource Providers
+func resourceProvidersToRegister(d *schema.ResourceData) (map[string]struct{}, error) {
+	if d.Get("skip_provider_registration").(bool) {
+		return map[string]struct{}{}, nil
+	}
+
+	additional := make([]string, 0)
+	for _, v := range d.Get("resource_providers_to_register").([]interface{}) {
+		additional = append(additional, v.(string))
+	}
+
+	return resourceproviders.ForRegistrationSet(d.Get("resource_provider_registrations").(string), additional)
+}
+
+// onDemandRegistrationTimeout is the maximum time spent registering a Resource Provider on-demand, which is
+// separate from the Create timeout of the resource so that registering doesn't consume (or exceed) it
+const onDemandRegistrationTimeout = 10 * time.Minute
+
+// registerResourceProvidersOnDemand wraps the Create function for the specified Resource so that when
+// the Resource Manager API returns a `MissingSubscriptionRegistration` error, the Resource Provider is
+// registered and the Create is retried once.
+//
+// This isn't done when `skip_provider_registration` is enabled, since in this case the Resource
+// Providers are expected to be registered outside of Terraform.
+func registerResourceProvidersOnDemand(resourceType string, resource *schema.Resource) {
+	create := resource.Create
+	if create == nil {
+		return
+	}
+
+	resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+		err := create(d, meta)
+		if err == nil {
+			return nil
+		}
+
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil || client.Account.SkipResourceProviderRegistration {
+			return err
+		}
+
+		namespace := resourceproviders.NamespaceRequiringRegistration(err)
+		if namespace == nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] The Resource Provider %q used by %q is not registered - registering and retrying..", *namespace, resourceType)
+		ctx, cancel := context.WithTimeout(client.StopContext, onDemandRegistrationTimeout)
+		defer cancel()
+		if regErr := resourceproviders.RegisterAndWait(ctx, client.Resource.ProvidersClient, *namespace, onDemandRegistrationTimeout); regErr != nil {
+			return fmt.Errorf("%+v\n\nThe Resource Provider %q isn't registered and couldn't be registered automatically: %+v", err, *namespace, regErr)
+		}
+
+		return create(d, meta)
+	}
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func schemaDefaultTimeouts() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Default timeouts for Resources matching a Resource Type or glob. Timeouts specified on a Resource take precedence over these.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateResourceTypePattern,
+					Description:  "The Resource Type (e.g. `azurerm_kubernetes_cluster`) or glob (e.g. `azurerm_kubernetes_*`) these timeouts apply to.",
+				},
+
+				"create": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTimeoutDuration,
+				},
+
+				"read": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTimeoutDuration,
+				},
+
+				"update": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTimeoutDuration,
+				},
+
+				"delete": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTimeoutDuration,
+				},
+			},
+		},
+	}
+}
+
+func expandDefaultTimeouts(input []interface{}) ([]timeouts.ResourceTypeDefaults, error) {
+	output := make([]timeouts.ResourceTypeDefaults, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		defaults := timeouts.ResourceTypeDefaults{
+			ResourceType: v["resource_type"].(string),
+		}
+
+		for key, field := range map[string]**time.Duration{
+			"create": &defaults.Create,
+			"read":   &defaults.Read,
+			"update": &defaults.Update,
+			"delete": &defaults.Delete,
+		} {
+			raw := v[key].(string)
+			if raw == "" {
+				continue
+			}
+
+			duration, err := time.ParseDuration(raw)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the %q timeout for %q: %+v", key, defaults.ResourceType, err)
+			}
+			*field = &duration
+		}
+
+		output = append(output, defaults)
+	}
+
+	return output, nil
+}
+
+// scopeTimeoutsToResourceType wraps the CRUD functions for the specified Resource (or Data Source) so
+// that the StopContext used to build the context for each operation is scoped to this Resource Type -
+// allowing the default timeouts configured in the Provider block to be resolved for it
+func scopeTimeoutsToResourceType(resourceType string, resource *schema.Resource) {
+	scoped := func(meta interface{}) interface{} {
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil {
+			return meta
+		}
+
+		output := *client
+		output.StopContext = timeouts.WithResourceType(client.StopContext, resourceType, resource)
+		return &output
+	}
+
+	wrap := func(fn func(d *schema.ResourceData, meta interface{}) error) func(d *schema.ResourceData, meta interface{}) error {
+		if fn == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return fn(d, scoped(meta))
+		}
+	}
+
+	resource.Create = wrap(resource.Create)
+	resource.Read = wrap(resource.Read)
+	resource.Update = wrap(resource.Update)
+	resource.Delete = wrap(resource.Delete)
+}
+
+func validateResourceTypePattern(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, errs := validation.StringIsNotWhiteSpace(v, k); len(errs) > 0 {
+		return nil, errs
+	}
+
+	if _, err := path.Match(v, ""); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a Resource Type or a valid glob: %+v", k, err)}
+	}
+
+	return nil, nil
+}
+
+func validateTimeoutDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q cannot be parsed as a duration (e.g. `90m`): %+v", k, err)}
+	}
+
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("%q must be greater than zero", k)}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"resource_type": "azurerm_kubernetes_*",
+			"create":        "3h",
+			"read":          "",
+			"update":        "90m",
+			"delete":        "",
+		},
+	}
+
+	output, err := expandDefaultTimeouts(input)
+	if err != nil {
+		t.Fatalf("expanding default timeouts: %+v", err)
+	}
+
+	if len(output) != 1 {
+		t.Fatalf("expected 1 item but got %d", len(output))
+	}
+
+	v := output[0]
+	if v.ResourceType != "azurerm_kubernetes_*" {
+		t.Fatalf("expected the Resource Type to be %q but got %q", "azurerm_kubernetes_*", v.ResourceType)
+	}
+	if v.Create == nil || *v.Create != 3*time.Hour {
+		t.Fatalf("expected the Create timeout to be 3h but got %+v", v.Create)
+	}
+	if v.Read != nil {
+		t.Fatalf("expected the Read timeout to be nil but got %+v", v.Read)
+	}
+	if v.Update == nil || *v.Update != 90*time.Minute {
+		t.Fatalf("expected the Update timeout to be 90m but got %+v", v.Update)
+	}
+	if v.Delete != nil {
+		t.Fatalf("expected the Delete timeout to be nil but got %+v", v.Delete)
+	}
+}
+
+func TestValidateTimeoutDuration(t *testing.T) {
+	testData := map[string]bool{
+		"":      false,
+		"90":    false,
+		"-5m":   false,
+		"0s":    false,
+		"90m":   true,
+		"1h30m": true,
+	}
+
+	for input, valid := range testData {
+		_, errs := validateTimeoutDuration(input, "create")
+		if valid != (len(errs) == 0) {
+			t.Fatalf("expected %q to be valid: %t but got errors %+v", input, valid, errs)
+		}
+	}
+}
+
+func TestScopeTimeoutsToResourceType(t *testing.T) {
+	read := 5 * time.Minute
+	override := time.Hour
+	client := &clients.Client{
+		StopContext: timeouts.WithProviderDefaults(context.TODO(), []timeouts.ResourceTypeDefaults{
+			{
+				ResourceType: "azurerm_example_*",
+				Read:         &override,
+			},
+		}),
+	}
+
+	var remaining time.Duration
+	resource := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+			defer cancel()
+
+			deadline, _ := ctx.Deadline()
+			remaining = time.Until(deadline)
+			return nil
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: &read,
+		},
+	}
+	scopeTimeoutsToResourceType("azurerm_example_resource", resource)
+
+	if err := resource.Read(resource.Data(&terraform.InstanceState{}), client); err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+	if remaining <= read {
+		t.Fatalf("expected the Read timeout to be overridden with %v but got %v", override, remaining)
+	}
+}
@@ -0,0 +1,71 @@
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// the error returned from the Resource Manager API when a Resource Provider isn't registered, e.g:
+// > Code="MissingSubscriptionRegistration" Message="The subscription is not registered to use namespace 'Microsoft.Foo'.
+var missingSubscriptionRegistrationRegex = regexp.MustCompile(`(?i)not registered to use namespace '([^']+)'`)
+
+// NamespaceRequiringRegistration returns the Resource Provider Namespace which requires registration
+// when the specified error is a `MissingSubscriptionRegistration` error - otherwise nil is returned
+func NamespaceRequiringRegistration(err error) *string {
+	if err == nil || !strings.Contains(err.Error(), "MissingSubscriptionRegistration") {
+		return nil
+	}
+
+	matches := missingSubscriptionRegistrationRegex.FindStringSubmatch(err.Error())
+	if len(matches) != 2 {
+		return nil
+	}
+
+	return &matches[1]
+}
+
+// RegisterAndWait registers the specified Resource Provider Namespace and then waits for it to become registered
+func RegisterAndWait(ctx context.Context, client *resources.ProvidersClient, namespace string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Registering Resource Provider %q..", namespace)
+	if _, err := client.Register(ctx, namespace); err != nil {
+		return fmt.Errorf("registering Resource Provider %q: %+v", namespace, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Resource Provider %q to finish registering..", namespace)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Processing"},
+		Target:       []string{"Registered"},
+		Refresh:      registrationStateRefreshFunc(ctx, client, namespace),
+		MinTimeout:   15 * time.Second,
+		PollInterval: 30 * time.Second,
+		Timeout:      timeout,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for Resource Provider %q to be registered: %+v", namespace, err)
+	}
+	log.Printf("[DEBUG] Registered Resource Provider %q.", namespace)
+
+	return nil
+}
+
+func registrationStateRefreshFunc(ctx context.Context, client *resources.ProvidersClient, namespace string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, namespace, "")
+		if err != nil {
+			return resp, "Failed", err
+		}
+
+		if resp.RegistrationState != nil && strings.EqualFold(*resp.RegistrationState, "Registered") {
+			return resp, "Registered", nil
+		}
+
+		return resp, "Processing", nil
+	}
+}
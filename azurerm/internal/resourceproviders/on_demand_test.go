@@ -0,0 +1,51 @@
+package resourceproviders
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNamespaceRequiringRegistration(t *testing.T) {
+	testData := []struct {
+		Input    error
+		Expected *string
+	}{
+		{
+			Input:    nil,
+			Expected: nil,
+		},
+		{
+			Input:    fmt.Errorf("creating Resource Group %q: some other error", "example"),
+			Expected: nil,
+		},
+		{
+			Input:    fmt.Errorf(`Code="MissingSubscriptionRegistration" Message="The subscription is registered"`),
+			Expected: nil,
+		},
+		{
+			Input:    fmt.Errorf(`creating Cluster: containerservice.ManagedClustersClient#CreateOrUpdate: Failure sending request: StatusCode=409 -- Original Error: Code="MissingSubscriptionRegistration" Message="The subscription is not registered to use namespace 'Microsoft.ContainerService'. See https://aka.ms/rps-not-found for how to register subscriptions."`),
+			Expected: stringPointer("Microsoft.ContainerService"),
+		},
+		{
+			Input:    fmt.Errorf(`Code="MissingSubscriptionRegistration" Message="The subscription is not registered to use namespace 'microsoft.insights'."`),
+			Expected: stringPointer("microsoft.insights"),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %v..", v.Input)
+
+		actual := NamespaceRequiringRegistration(v.Input)
+		if v.Expected == nil && actual == nil {
+			continue
+		}
+
+		if v.Expected == nil || actual == nil || *v.Expected != *actual {
+			t.Fatalf("Expected %v but got %v", v.Expected, actual)
+		}
+	}
+}
+
+func stringPointer(input string) *string {
+	return &input
+}
@@ -74,3 +74,22 @@ func Required() map[string]struct{} {
 		"Microsoft.Web":                     {},
 	}
 }
+
+// Core returns the Resource Providers which are used by the most commonly used resources
+// within the AzureRM Provider - other Resource Providers can then be registered on-demand
+// or as required
+func Core() map[string]struct{} {
+	// NOTE: Resource Providers in this list are case sensitive
+	return map[string]struct{}{
+		"Microsoft.Authorization":       {},
+		"Microsoft.Compute":             {},
+		"Microsoft.ContainerService":    {},
+		"Microsoft.KeyVault":            {},
+		"Microsoft.ManagedIdentity":     {},
+		"microsoft.insights":            {},
+		"Microsoft.Network":             {},
+		"Microsoft.OperationalInsights": {},
+		"Microsoft.Resources":           {},
+		"Microsoft.Storage":             {},
+	}
+}
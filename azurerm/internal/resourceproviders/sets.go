@@ -0,0 +1,48 @@
+package resourceproviders
+
+import (
+	"fmt"
+)
+
+const (
+	// RegistrationSetAll registers all of the Resource Providers supported by the AzureRM Provider
+	RegistrationSetAll = "all"
+
+	// RegistrationSetCore registers only the Resource Providers used by the most common resources
+	RegistrationSetCore = "core"
+
+	// RegistrationSetNone registers no Resource Providers, other than those explicitly specified
+	RegistrationSetNone = "none"
+)
+
+// PossibleRegistrationSets returns the Registration Sets which can be specified in the Provider block
+func PossibleRegistrationSets() []string {
+	return []string{
+		RegistrationSetAll,
+		RegistrationSetCore,
+		RegistrationSetNone,
+	}
+}
+
+// ForRegistrationSet returns the Resource Providers which should be registered for the specified
+// Registration Set, in addition to any explicitly specified Resource Providers
+func ForRegistrationSet(registrationSet string, additional []string) (map[string]struct{}, error) {
+	output := make(map[string]struct{})
+
+	switch registrationSet {
+	case RegistrationSetAll:
+		output = Required()
+	case RegistrationSetCore:
+		output = Core()
+	case RegistrationSetNone:
+		// nothing to register
+	default:
+		return nil, fmt.Errorf("unsupported Resource Provider Registration Set %q", registrationSet)
+	}
+
+	for _, v := range additional {
+		output[v] = struct{}{}
+	}
+
+	return output, nil
+}
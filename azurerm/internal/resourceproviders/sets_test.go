@@ -0,0 +1,79 @@
+package resourceproviders
+
+import (
+	"testing"
+)
+
+func TestForRegistrationSet(t *testing.T) {
+	testData := []struct {
+		Name            string
+		RegistrationSet string
+		Additional      []string
+		ExpectedCount   int
+		Expected        []string
+		ShouldError     bool
+	}{
+		{
+			Name:            "All",
+			RegistrationSet: RegistrationSetAll,
+			ExpectedCount:   len(Required()),
+			Expected:        []string{"Microsoft.Compute", "Microsoft.Web"},
+		},
+		{
+			Name:            "Core",
+			RegistrationSet: RegistrationSetCore,
+			ExpectedCount:   len(Core()),
+			Expected:        []string{"Microsoft.Compute", "Microsoft.Network"},
+		},
+		{
+			Name:            "Core with Additional",
+			RegistrationSet: RegistrationSetCore,
+			Additional:      []string{"Microsoft.Web", "Microsoft.Network"},
+			ExpectedCount:   len(Core()) + 1,
+			Expected:        []string{"Microsoft.Network", "Microsoft.Web"},
+		},
+		{
+			Name:            "None",
+			RegistrationSet: RegistrationSetNone,
+			ExpectedCount:   0,
+		},
+		{
+			Name:            "None with Additional",
+			RegistrationSet: RegistrationSetNone,
+			Additional:      []string{"Microsoft.Web"},
+			ExpectedCount:   1,
+			Expected:        []string{"Microsoft.Web"},
+		},
+		{
+			Name:            "Unsupported",
+			RegistrationSet: "some",
+			ShouldError:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := ForRegistrationSet(v.RegistrationSet, v.Additional)
+		if err != nil {
+			if v.ShouldError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.ShouldError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if len(actual) != v.ExpectedCount {
+			t.Fatalf("Expected %d Resource Providers but got %d", v.ExpectedCount, len(actual))
+		}
+
+		for _, expected := range v.Expected {
+			if _, ok := actual[expected]; !ok {
+				t.Fatalf("Expected %q to be registered but it wasn't", expected)
+			}
+		}
+	}
+}
@@ -2,13 +2,16 @@ package containers
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-12-01/containerservice"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	logAnalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 
 	laparse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
@@ -16,11 +19,14 @@ import (
 
 const (
 	// note: the casing on these keys is important
-	aciConnectorKey           = "aciConnectorLinux"
-	azurePolicyKey            = "azurepolicy"
-	kubernetesDashboardKey    = "kubeDashboard"
-	httpApplicationRoutingKey = "httpApplicationRouting"
-	omsAgentKey               = "omsagent"
+	aciConnectorKey                 = "aciConnectorLinux"
+	azurePolicyKey                  = "azurepolicy"
+	azureKeyvaultSecretsProviderKey = "azureKeyvaultSecretsProvider"
+	kubernetesDashboardKey          = "kubeDashboard"
+	httpApplicationRoutingKey       = "httpApplicationRouting"
+	ingressApplicationGatewayKey    = "ingressApplicationGateway"
+	omsAgentKey                     = "omsagent"
+	openServiceMeshKey              = "openServiceMesh"
 )
 
 // The AKS API hard-codes which add-ons are supported in which environment
@@ -84,6 +90,69 @@ func schemaKubernetesAddOnProfiles() *schema.Schema {
 					},
 				},
 
+				"azure_keyvault_secrets_provider": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+							"secret_rotation_enabled": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+							"secret_rotation_interval": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "2m",
+								ValidateFunc: validateKubernetesAddOnDuration,
+							},
+							"secret_identity": schemaKubernetesAddOnIdentity(),
+						},
+					},
+				},
+
+				"ingress_application_gateway": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+							"gateway_id": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								ConflictsWith: []string{"addon_profile.0.ingress_application_gateway.0.subnet_cidr", "addon_profile.0.ingress_application_gateway.0.subnet_id"},
+								ValidateFunc:  networkValidate.ApplicationGatewayID,
+							},
+							"subnet_cidr": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								ConflictsWith: []string{"addon_profile.0.ingress_application_gateway.0.gateway_id", "addon_profile.0.ingress_application_gateway.0.subnet_id"},
+								ValidateFunc:  validation.IsCIDR,
+							},
+							"subnet_id": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								ConflictsWith: []string{"addon_profile.0.ingress_application_gateway.0.gateway_id", "addon_profile.0.ingress_application_gateway.0.subnet_cidr"},
+								ValidateFunc:  networkValidate.SubnetID,
+							},
+							"effective_gateway_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"ingress_application_gateway_identity": schemaKubernetesAddOnIdentity(),
+						},
+					},
+				},
+
 				"kube_dashboard": {
 					Type:     schema.TypeList,
 					MaxItems: 1,
@@ -131,25 +200,20 @@ func schemaKubernetesAddOnProfiles() *schema.Schema {
 								Optional:     true,
 								ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
 							},
-							"oms_agent_identity": {
-								Type:     schema.TypeList,
-								Computed: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"client_id": {
-											Type:     schema.TypeString,
-											Computed: true,
-										},
-										"object_id": {
-											Type:     schema.TypeString,
-											Computed: true,
-										},
-										"user_assigned_identity_id": {
-											Type:     schema.TypeString,
-											Computed: true,
-										},
-									},
-								},
+							"oms_agent_identity": schemaKubernetesAddOnIdentity(),
+						},
+					},
+				},
+
+				"open_service_mesh": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Type:     schema.TypeBool,
+								Required: true,
 							},
 						},
 					},
@@ -159,11 +223,39 @@ func schemaKubernetesAddOnProfiles() *schema.Schema {
 	}
 }
 
+// schemaKubernetesAddOnIdentity returns the schema for the identity which AKS creates for an add-on,
+// which is exported so that it can be granted access to other resources (e.g. via a Role Assignment)
+func schemaKubernetesAddOnIdentity() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"client_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"object_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"user_assigned_identity_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func expandKubernetesAddOnProfiles(input []interface{}, env azure.Environment) (*map[string]*containerservice.ManagedClusterAddonProfile, error) {
 	disabled := containerservice.ManagedClusterAddonProfile{
 		Enabled: utils.Bool(false),
 	}
 
+	// the Ingress Application Gateway, Key Vault Secrets Provider and Open Service Mesh add-ons aren't available
+	// in every environment (and the API rejects unsupported add-ons, even when disabled) - so these are only
+	// sent when they're configured, rather than being disabled by default
 	profiles := map[string]*containerservice.ManagedClusterAddonProfile{
 		aciConnectorKey:           &disabled,
 		azurePolicyKey:            &disabled,
@@ -248,6 +340,59 @@ func expandKubernetesAddOnProfiles(input []interface{}, env azure.Environment) (
 		}
 	}
 
+	ingressApplicationGateway := profile["ingress_application_gateway"].([]interface{})
+	if len(ingressApplicationGateway) > 0 && ingressApplicationGateway[0] != nil {
+		value := ingressApplicationGateway[0].(map[string]interface{})
+		config := make(map[string]*string)
+		enabled := value["enabled"].(bool)
+
+		if gatewayId, ok := value["gateway_id"]; ok && gatewayId != "" {
+			config["applicationGatewayId"] = utils.String(gatewayId.(string))
+		}
+
+		if subnetCIDR, ok := value["subnet_cidr"]; ok && subnetCIDR != "" {
+			config["subnetCIDR"] = utils.String(subnetCIDR.(string))
+		}
+
+		if subnetId, ok := value["subnet_id"]; ok && subnetId != "" {
+			config["subnetId"] = utils.String(subnetId.(string))
+		}
+
+		addonProfiles[ingressApplicationGatewayKey] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+			Config:  config,
+		}
+	}
+
+	azureKeyvaultSecretsProvider := profile["azure_keyvault_secrets_provider"].([]interface{})
+	if len(azureKeyvaultSecretsProvider) > 0 && azureKeyvaultSecretsProvider[0] != nil {
+		value := azureKeyvaultSecretsProvider[0].(map[string]interface{})
+		enabled := value["enabled"].(bool)
+
+		config := map[string]*string{
+			"enableSecretRotation": utils.String(strconv.FormatBool(value["secret_rotation_enabled"].(bool))),
+		}
+		if rotationPollInterval := value["secret_rotation_interval"].(string); rotationPollInterval != "" {
+			config["rotationPollInterval"] = utils.String(rotationPollInterval)
+		}
+
+		addonProfiles[azureKeyvaultSecretsProviderKey] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+			Config:  config,
+		}
+	}
+
+	openServiceMesh := profile["open_service_mesh"].([]interface{})
+	if len(openServiceMesh) > 0 && openServiceMesh[0] != nil {
+		value := openServiceMesh[0].(map[string]interface{})
+		enabled := value["enabled"].(bool)
+
+		addonProfiles[openServiceMeshKey] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+			Config:  nil,
+		}
+	}
+
 	return filterUnsupportedKubernetesAddOns(addonProfiles, env)
 }
 
@@ -355,7 +500,7 @@ func flattenKubernetesAddOnProfiles(profile map[string]*containerservice.Managed
 			}
 		}
 
-		omsagentIdentity := flattenKubernetesClusterOmsAgentIdentityProfile(omsAgent.Identity)
+		omsagentIdentity := flattenKubernetesClusterAddOnIdentityProfile(omsAgent.Identity)
 
 		omsAgents = append(omsAgents, map[string]interface{}{
 			"enabled":                    enabled,
@@ -364,23 +509,104 @@ func flattenKubernetesAddOnProfiles(profile map[string]*containerservice.Managed
 		})
 	}
 
+	ingressApplicationGateways := make([]interface{}, 0)
+	if ingressApplicationGateway := kubernetesAddonProfileLocate(profile, ingressApplicationGatewayKey); ingressApplicationGateway != nil {
+		enabled := false
+		if enabledVal := ingressApplicationGateway.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		gatewayId := ""
+		if v := kubernetesAddonProfilelocateInConfig(ingressApplicationGateway.Config, "applicationGatewayId"); v != nil {
+			gatewayId = *v
+		}
+
+		effectiveGatewayId := ""
+		if v := kubernetesAddonProfilelocateInConfig(ingressApplicationGateway.Config, "effectiveApplicationGatewayId"); v != nil {
+			effectiveGatewayId = *v
+		}
+
+		subnetCIDR := ""
+		if v := kubernetesAddonProfilelocateInConfig(ingressApplicationGateway.Config, "subnetCIDR"); v != nil {
+			subnetCIDR = *v
+		}
+
+		subnetId := ""
+		if v := kubernetesAddonProfilelocateInConfig(ingressApplicationGateway.Config, "subnetId"); v != nil {
+			subnetId = *v
+		}
+
+		ingressApplicationGatewayIdentity := flattenKubernetesClusterAddOnIdentityProfile(ingressApplicationGateway.Identity)
+
+		ingressApplicationGateways = append(ingressApplicationGateways, map[string]interface{}{
+			"enabled":                              enabled,
+			"gateway_id":                           gatewayId,
+			"effective_gateway_id":                 effectiveGatewayId,
+			"subnet_cidr":                          subnetCIDR,
+			"subnet_id":                            subnetId,
+			"ingress_application_gateway_identity": ingressApplicationGatewayIdentity,
+		})
+	}
+
+	azureKeyvaultSecretsProviders := make([]interface{}, 0)
+	if azureKeyvaultSecretsProvider := kubernetesAddonProfileLocate(profile, azureKeyvaultSecretsProviderKey); azureKeyvaultSecretsProvider != nil {
+		enabled := false
+		if enabledVal := azureKeyvaultSecretsProvider.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		secretRotationEnabled := false
+		if v := kubernetesAddonProfilelocateInConfig(azureKeyvaultSecretsProvider.Config, "enableSecretRotation"); v != nil {
+			secretRotationEnabled = strings.EqualFold(*v, "true")
+		}
+
+		secretRotationInterval := ""
+		if v := kubernetesAddonProfilelocateInConfig(azureKeyvaultSecretsProvider.Config, "rotationPollInterval"); v != nil {
+			secretRotationInterval = *v
+		}
+
+		secretIdentity := flattenKubernetesClusterAddOnIdentityProfile(azureKeyvaultSecretsProvider.Identity)
+
+		azureKeyvaultSecretsProviders = append(azureKeyvaultSecretsProviders, map[string]interface{}{
+			"enabled":                  enabled,
+			"secret_rotation_enabled":  secretRotationEnabled,
+			"secret_rotation_interval": secretRotationInterval,
+			"secret_identity":          secretIdentity,
+		})
+	}
+
+	openServiceMeshes := make([]interface{}, 0)
+	if openServiceMesh := kubernetesAddonProfileLocate(profile, openServiceMeshKey); openServiceMesh != nil {
+		enabled := false
+		if enabledVal := openServiceMesh.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		openServiceMeshes = append(openServiceMeshes, map[string]interface{}{
+			"enabled": enabled,
+		})
+	}
+
 	// this is a UX hack, since if the top level block isn't defined everything should be turned off
-	if len(aciConnectors) == 0 && len(azurePolicies) == 0 && len(httpApplicationRoutes) == 0 && len(kubeDashboards) == 0 && len(omsAgents) == 0 {
+	if len(aciConnectors) == 0 && len(azurePolicies) == 0 && len(azureKeyvaultSecretsProviders) == 0 && len(httpApplicationRoutes) == 0 && len(ingressApplicationGateways) == 0 && len(kubeDashboards) == 0 && len(omsAgents) == 0 && len(openServiceMeshes) == 0 {
 		return []interface{}{}
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"aci_connector_linux":      aciConnectors,
-			"azure_policy":             azurePolicies,
-			"http_application_routing": httpApplicationRoutes,
-			"kube_dashboard":           kubeDashboards,
-			"oms_agent":                omsAgents,
+			"aci_connector_linux":             aciConnectors,
+			"azure_keyvault_secrets_provider": azureKeyvaultSecretsProviders,
+			"azure_policy":                    azurePolicies,
+			"http_application_routing":        httpApplicationRoutes,
+			"ingress_application_gateway":     ingressApplicationGateways,
+			"kube_dashboard":                  kubeDashboards,
+			"oms_agent":                       omsAgents,
+			"open_service_mesh":               openServiceMeshes,
 		},
 	}
 }
 
-func flattenKubernetesClusterOmsAgentIdentityProfile(profile *containerservice.ManagedClusterAddonProfileIdentity) []interface{} {
+func flattenKubernetesClusterAddOnIdentityProfile(profile *containerservice.ManagedClusterAddonProfileIdentity) []interface{} {
 	if profile == nil {
 		return []interface{}{}
 	}
@@ -410,6 +636,19 @@ func flattenKubernetesClusterOmsAgentIdentityProfile(profile *containerservice.M
 	return identity
 }
 
+func validateKubernetesAddOnDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, err := time.ParseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration (e.g. `2m`): %+v", k, err)}
+	}
+
+	return nil, nil
+}
+
 // when the Kubernetes Cluster is updated in the Portal - Azure updates the casing on the keys
 // meaning what's submitted could be different to what's returned..
 func kubernetesAddonProfileLocate(profile map[string]*containerservice.ManagedClusterAddonProfile, key string) *containerservice.ManagedClusterAddonProfile {
@@ -107,6 +107,10 @@ func resourceKubernetesClusterNodePool() *schema.Resource {
 				}, false),
 			},
 
+			"kubelet_config": schemaNodePoolKubeletConfig(),
+
+			"linux_os_config": schemaNodePoolLinuxOSConfig("linux_os_config"),
+
 			"max_count": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -304,6 +308,7 @@ func resourceKubernetesClusterNodePoolCreate(d *schema.ResourceData, meta interf
 		Type:                   containerservice.VirtualMachineScaleSets,
 		VMSize:                 containerservice.VMSizeTypes(vmSize),
 		EnableEncryptionAtHost: utils.Bool(enableHostEncryption),
+		KubeletConfig:          expandNodePoolKubeletConfig(d.Get("kubelet_config").([]interface{})),
 		UpgradeSettings:        expandUpgradeSettings(d.Get("upgrade_settings").([]interface{})),
 
 		// this must always be sent during creation, but is optional for auto-scaled clusters during update
@@ -323,6 +328,14 @@ func resourceKubernetesClusterNodePoolCreate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if linuxOSConfigRaw := d.Get("linux_os_config").([]interface{}); len(linuxOSConfigRaw) > 0 {
+		if osType != string(containerservice.Linux) {
+			return fmt.Errorf("`linux_os_config` can only be configured when `os_type` is set to `Linux`")
+		}
+
+		profile.LinuxOSConfig = expandNodePoolLinuxOSConfig(linuxOSConfigRaw)
+	}
+
 	orchestratorVersion := d.Get("orchestrator_version").(string)
 	if orchestratorVersion != "" {
 		if err := validateNodePoolSupportsVersion(ctx, containersClient, resourceGroup, clusterName, name, orchestratorVersion); err != nil {
@@ -614,6 +627,18 @@ func resourceKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interfac
 		d.Set("enable_node_public_ip", props.EnableNodePublicIP)
 		d.Set("enable_host_encryption", props.EnableEncryptionAtHost)
 
+		if err := d.Set("kubelet_config", flattenNodePoolKubeletConfig(props.KubeletConfig)); err != nil {
+			return fmt.Errorf("setting `kubelet_config`: %+v", err)
+		}
+
+		linuxOSConfig, err := flattenNodePoolLinuxOSConfig(props.LinuxOSConfig)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("linux_os_config", linuxOSConfig); err != nil {
+			return fmt.Errorf("setting `linux_os_config`: %+v", err)
+		}
+
 		evictionPolicy := ""
 		if props.ScaleSetEvictionPolicy != "" {
 			evictionPolicy = string(props.ScaleSetEvictionPolicy)
@@ -15,10 +15,13 @@ var kubernetesOtherTests = map[string]func(t *testing.T){
 	"basicVMSS":                      testAccKubernetesCluster_basicVMSS,
 	"requiresImport":                 testAccKubernetesCluster_requiresImport,
 	"criticalAddonsTaint":            testAccKubernetesCluster_criticalAddonsTaint,
+	"kubeletAndLinuxOSConfig":        testAccKubernetesCluster_kubeletAndLinuxOSConfig,
 	"linuxProfile":                   testAccKubernetesCluster_linuxProfile,
+	"maintenanceWindow":              testAccKubernetesCluster_maintenanceWindow,
 	"nodeLabels":                     testAccKubernetesCluster_nodeLabels,
 	"nodeResourceGroup":              testAccKubernetesCluster_nodeResourceGroup,
 	"paidSku":                        testAccKubernetesCluster_paidSku,
+	"podIdentityProfile":             testAccKubernetesCluster_podIdentityProfile,
 	"upgradeConfig":                  testAccKubernetesCluster_upgrade,
 	"tags":                           testAccKubernetesCluster_tags,
 	"windowsProfile":                 testAccKubernetesCluster_windowsProfile,
@@ -171,6 +174,108 @@ func testAccKubernetesCluster_criticalAddonsTaint(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_kubeletAndLinuxOSConfig(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_kubeletAndLinuxOSConfig(t)
+}
+
+func testAccKubernetesCluster_kubeletAndLinuxOSConfig(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.kubeletAndLinuxOSConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_node_pool.0.kubelet_config.0.cpu_manager_policy").HasValue("static"),
+				check.That(data.ResourceName).Key("default_node_pool.0.linux_os_config.0.sysctl_config.0.net_core_somaxconn").HasValue("5000"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesCluster_maintenanceWindow(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_maintenanceWindow(t)
+}
+
+func testAccKubernetesCluster_maintenanceWindow(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.maintenanceWindowConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.#").HasValue("1"),
+				check.That(data.ResourceName).Key("maintenance_window.0.allowed.#").HasValue("1"),
+				check.That(data.ResourceName).Key("maintenance_window.0.not_allowed.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.maintenanceWindowCompleteConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.0.allowed.#").HasValue("2"),
+				check.That(data.ResourceName).Key("maintenance_window.0.not_allowed.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basicVMSSConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesCluster_podIdentityProfile(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_podIdentityProfile(t)
+}
+
+func testAccKubernetesCluster_podIdentityProfile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.podIdentityProfileConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.user_assigned_identity.#").HasValue("1"),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.user_assigned_identity.0.client_id").Exists(),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.user_assigned_identity.0.object_id").Exists(),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.exception.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.podIdentityProfileDisabledConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.enabled").HasValue("false"),
+			),
+		},
+		{
+			Config: r.podIdentityProfileRemovedConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKubernetesCluster_linuxProfile(t *testing.T) {
 	checkIfShouldRunTestsIndividually(t)
 	testAccKubernetesCluster_linuxProfile(t)
@@ -774,6 +879,56 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (KubernetesClusterResource) kubeletAndLinuxOSConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+
+    kubelet_config {
+      cpu_manager_policy      = "static"
+      cpu_cfs_quota_enabled   = true
+      image_gc_high_threshold = 90
+      image_gc_low_threshold  = 70
+      allowed_unsafe_sysctls  = ["kernel.msg*"]
+    }
+
+    linux_os_config {
+      transparent_huge_page_enabled = "madvise"
+      transparent_huge_page_defrag  = "defer+madvise"
+
+      sysctl_config {
+        net_core_somaxconn               = 5000
+        net_ipv4_ip_local_port_range_min = 32768
+        net_ipv4_ip_local_port_range_max = 60999
+        vm_max_map_count                 = 262144
+      }
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
 func (KubernetesClusterResource) linuxProfileConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1267,3 +1422,227 @@ resource "azurerm_kubernetes_cluster" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, controlPlaneVersion, upgradeChannel)
 }
+
+func (KubernetesClusterResource) maintenanceWindowConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  maintenance_window {
+    allowed {
+      day   = "Monday"
+      hours = [1, 2]
+    }
+
+    not_allowed {
+      start = "2021-11-26T03:00:00Z"
+      end   = "2021-11-30T12:00:00Z"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (KubernetesClusterResource) maintenanceWindowCompleteConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  maintenance_window {
+    allowed {
+      day   = "Monday"
+      hours = [1, 2]
+    }
+
+    allowed {
+      day   = "Sunday"
+      hours = [0, 1, 2, 3]
+    }
+
+    not_allowed {
+      start = "2021-11-26T03:00:00Z"
+      end   = "2021-11-30T12:00:00Z"
+    }
+
+    not_allowed {
+      start = "2021-12-24T00:00:00Z"
+      end   = "2021-12-27T00:00:00Z"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (KubernetesClusterResource) podIdentityProfileConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-pod-identity-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  network_profile {
+    network_plugin = "azure"
+  }
+
+  pod_identity_profile {
+    enabled = true
+
+    user_assigned_identity {
+      name        = "example"
+      namespace   = "default"
+      identity_id = azurerm_user_assigned_identity.test.id
+    }
+
+    exception {
+      name      = "example-exception"
+      namespace = "kube-system"
+      pod_labels = {
+        app = "example"
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (KubernetesClusterResource) podIdentityProfileDisabledConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  network_profile {
+    network_plugin = "azure"
+  }
+
+  pod_identity_profile {
+    enabled = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (KubernetesClusterResource) podIdentityProfileRemovedConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  network_profile {
+    network_plugin = "azure"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -627,6 +627,10 @@ func resourceKubernetesCluster() *schema.Resource {
 				}, false),
 			},
 
+			"maintenance_window": schemaKubernetesClusterMaintenanceWindow(),
+
+			"pod_identity_profile": schemaKubernetesClusterPodIdentityProfile(),
+
 			// Computed
 			"fqdn": {
 				Type:     schema.TypeString,
@@ -841,6 +845,13 @@ func resourceKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if podIdentityProfile := expandKubernetesClusterPodIdentityProfile(d.Get("pod_identity_profile").([]interface{})); podIdentityProfile != nil {
+		if err := populateKubernetesClusterPodIdentityProfile(ctx, meta.(*clients.Client).MSI.UserAssignedIdentitiesClient, podIdentityProfile); err != nil {
+			return fmt.Errorf("expanding `pod_identity_profile`: %+v", err)
+		}
+		parameters.ManagedClusterProperties.PodIdentityProfile = podIdentityProfile
+	}
+
 	managedClusterIdentityRaw := d.Get("identity").([]interface{})
 	servicePrincipalProfileRaw := d.Get("service_principal").([]interface{})
 
@@ -896,6 +907,16 @@ func resourceKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(*read.ID)
 
+	if maintenanceConfig := expandKubernetesClusterMaintenanceConfiguration(d.Get("maintenance_window").([]interface{})); maintenanceConfig != nil {
+		maintenanceClient := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
+		parameters := containerservice.MaintenanceConfiguration{
+			MaintenanceConfigurationProperties: maintenanceConfig,
+		}
+		if _, err := maintenanceClient.CreateOrUpdate(ctx, resGroup, name, kubernetesMaintenanceConfigurationName, parameters); err != nil {
+			return fmt.Errorf("creating/updating Maintenance Configuration for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
 	return resourceKubernetesClusterRead(d, meta)
 }
 
@@ -1017,6 +1038,16 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 		existing.ManagedClusterProperties.AddonProfiles = *addonProfiles
 	}
 
+	if d.HasChange("pod_identity_profile") {
+		updateCluster = true
+		podIdentityProfile := expandKubernetesClusterPodIdentityProfile(d.Get("pod_identity_profile").([]interface{}))
+		if err := populateKubernetesClusterPodIdentityProfile(ctx, meta.(*clients.Client).MSI.UserAssignedIdentitiesClient, podIdentityProfile); err != nil {
+			return fmt.Errorf("expanding `pod_identity_profile`: %+v", err)
+		}
+
+		existing.ManagedClusterProperties.PodIdentityProfile = podIdentityProfile
+	}
+
 	if d.HasChange("api_server_authorized_ip_ranges") {
 		updateCluster = true
 		apiServerAuthorizedIPRangesRaw := d.Get("api_server_authorized_ip_ranges").(*schema.Set).List()
@@ -1225,6 +1256,27 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[DEBUG] Updated Default Node Pool.")
 	}
 
+	if d.HasChange("maintenance_window") {
+		log.Printf("[DEBUG] Updating the Maintenance Configuration for Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
+		maintenanceClient := containersClient.MaintenanceConfigurationsClient
+
+		if maintenanceConfig := expandKubernetesClusterMaintenanceConfiguration(d.Get("maintenance_window").([]interface{})); maintenanceConfig != nil {
+			parameters := containerservice.MaintenanceConfiguration{
+				MaintenanceConfigurationProperties: maintenanceConfig,
+			}
+			if _, err := maintenanceClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, kubernetesMaintenanceConfigurationName, parameters); err != nil {
+				return fmt.Errorf("creating/updating Maintenance Configuration for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+			}
+		} else {
+			if resp, err := maintenanceClient.Delete(ctx, id.ResourceGroup, id.ManagedClusterName, kubernetesMaintenanceConfigurationName); err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("deleting Maintenance Configuration for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+				}
+			}
+		}
+		log.Printf("[DEBUG] Updated the Maintenance Configuration for Kubernetes Cluster %q (Resource Group %q).", id.ManagedClusterName, id.ResourceGroup)
+	}
+
 	d.Partial(false)
 
 	return resourceKubernetesClusterRead(d, meta)
@@ -1232,6 +1284,7 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	maintenanceClient := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1326,6 +1379,14 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("setting `linux_profile`: %+v", err)
 		}
 
+		podIdentityProfile, err := flattenKubernetesClusterPodIdentityProfile(props.PodIdentityProfile)
+		if err != nil {
+			return fmt.Errorf("flattening `pod_identity_profile`: %+v", err)
+		}
+		if err := d.Set("pod_identity_profile", podIdentityProfile); err != nil {
+			return fmt.Errorf("setting `pod_identity_profile`: %+v", err)
+		}
+
 		networkProfile := flattenKubernetesClusterNetworkProfile(props.NetworkProfile)
 		if err := d.Set("network_profile", networkProfile); err != nil {
 			return fmt.Errorf("setting `network_profile`: %+v", err)
@@ -1379,6 +1440,14 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `kube_config`: %+v", err)
 	}
 
+	maintenanceConfig, err := maintenanceClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName, kubernetesMaintenanceConfigurationName)
+	if err != nil && !utils.ResponseWasNotFound(maintenanceConfig.Response) {
+		return fmt.Errorf("retrieving Maintenance Configuration for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+	}
+	if err := d.Set("maintenance_window", flattenKubernetesClusterMaintenanceConfiguration(maintenanceConfig.MaintenanceConfigurationProperties)); err != nil {
+		return fmt.Errorf("setting `maintenance_window`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
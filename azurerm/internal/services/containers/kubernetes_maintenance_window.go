@@ -0,0 +1,218 @@
+package containers
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-12-01/containerservice"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// the AKS API only supports a single Maintenance Configuration per cluster, which must use this name
+const kubernetesMaintenanceConfigurationName = "default"
+
+func schemaKubernetesClusterMaintenanceWindow() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed": {
+					Type:         schema.TypeSet,
+					Optional:     true,
+					MinItems:     1,
+					AtLeastOneOf: []string{"maintenance_window.0.allowed", "maintenance_window.0.not_allowed"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"day": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(containerservice.Sunday),
+									string(containerservice.Monday),
+									string(containerservice.Tuesday),
+									string(containerservice.Wednesday),
+									string(containerservice.Thursday),
+									string(containerservice.Friday),
+									string(containerservice.Saturday),
+								}, false),
+							},
+
+							"hours": {
+								Type:     schema.TypeSet,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Schema{
+									Type:         schema.TypeInt,
+									ValidateFunc: validation.IntBetween(0, 23),
+								},
+							},
+						},
+					},
+				},
+
+				"not_allowed": {
+					Type:         schema.TypeSet,
+					Optional:     true,
+					MinItems:     1,
+					AtLeastOneOf: []string{"maintenance_window.0.allowed", "maintenance_window.0.not_allowed"},
+					Set:          kubernetesClusterMaintenanceWindowNotAllowedHash,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"start": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.IsRFC3339Time,
+							},
+
+							"end": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.IsRFC3339Time,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandKubernetesClusterMaintenanceConfiguration(input []interface{}) *containerservice.MaintenanceConfigurationProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	value := input[0].(map[string]interface{})
+
+	return &containerservice.MaintenanceConfigurationProperties{
+		TimeInWeek:     expandKubernetesClusterMaintenanceConfigurationTimeInWeeks(value["allowed"].(*schema.Set).List()),
+		NotAllowedTime: expandKubernetesClusterMaintenanceConfigurationTimeSpans(value["not_allowed"].(*schema.Set).List()),
+	}
+}
+
+func expandKubernetesClusterMaintenanceConfigurationTimeInWeeks(input []interface{}) *[]containerservice.TimeInWeek {
+	results := make([]containerservice.TimeInWeek, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		hourSlots := make([]int32, 0)
+		for _, hour := range v["hours"].(*schema.Set).List() {
+			hourSlots = append(hourSlots, int32(hour.(int)))
+		}
+
+		results = append(results, containerservice.TimeInWeek{
+			Day:       containerservice.WeekDay(v["day"].(string)),
+			HourSlots: &hourSlots,
+		})
+	}
+	return &results
+}
+
+func expandKubernetesClusterMaintenanceConfigurationTimeSpans(input []interface{}) *[]containerservice.TimeSpan {
+	results := make([]containerservice.TimeSpan, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		// these have been validated as RFC3339 timestamps in the schema
+		start, _ := time.Parse(time.RFC3339, v["start"].(string))
+		end, _ := time.Parse(time.RFC3339, v["end"].(string))
+
+		results = append(results, containerservice.TimeSpan{
+			Start: &date.Time{Time: start.UTC()},
+			End:   &date.Time{Time: end.UTC()},
+		})
+	}
+	return &results
+}
+
+func flattenKubernetesClusterMaintenanceConfiguration(input *containerservice.MaintenanceConfigurationProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	allowed := flattenKubernetesClusterMaintenanceConfigurationTimeInWeeks(input.TimeInWeek)
+	notAllowed := flattenKubernetesClusterMaintenanceConfigurationTimeSpans(input.NotAllowedTime)
+	if len(allowed) == 0 && len(notAllowed) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allowed":     allowed,
+			"not_allowed": notAllowed,
+		},
+	}
+}
+
+func flattenKubernetesClusterMaintenanceConfigurationTimeInWeeks(input *[]containerservice.TimeInWeek) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		hours := make([]interface{}, 0)
+		if item.HourSlots != nil {
+			for _, hour := range *item.HourSlots {
+				hours = append(hours, int(hour))
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"day":   string(item.Day),
+			"hours": hours,
+		})
+	}
+	return results
+}
+
+func flattenKubernetesClusterMaintenanceConfigurationTimeSpans(input *[]containerservice.TimeSpan) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		start := ""
+		if item.Start != nil {
+			start = item.Start.UTC().Format(time.RFC3339)
+		}
+
+		end := ""
+		if item.End != nil {
+			end = item.End.UTC().Format(time.RFC3339)
+		}
+
+		results = append(results, map[string]interface{}{
+			"start": start,
+			"end":   end,
+		})
+	}
+	return results
+}
+
+// kubernetesClusterMaintenanceWindowNotAllowedHash hashes the `start` and `end` of a `not_allowed` block in UTC,
+// since the API returns these in UTC (without fractional seconds) regardless of the offset they were specified with
+func kubernetesClusterMaintenanceWindowNotAllowedHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", normalizeKubernetesClusterMaintenanceWindowTime(m["start"].(string))))
+		buf.WriteString(fmt.Sprintf("%s-", normalizeKubernetesClusterMaintenanceWindowTime(m["end"].(string))))
+	}
+
+	return schema.HashString(buf.String())
+}
+
+func normalizeKubernetesClusterMaintenanceWindowTime(input string) string {
+	t, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return input
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,49 @@
+package containers
+
+import (
+	"testing"
+)
+
+func TestKubernetesClusterMaintenanceWindowNotAllowedHash(t *testing.T) {
+	testData := []struct {
+		Name  string
+		First map[string]interface{}
+		Other map[string]interface{}
+		Equal bool
+	}{
+		{
+			Name:  "Identical",
+			First: map[string]interface{}{"start": "2021-05-26T03:00:00Z", "end": "2021-05-30T12:00:00Z"},
+			Other: map[string]interface{}{"start": "2021-05-26T03:00:00Z", "end": "2021-05-30T12:00:00Z"},
+			Equal: true,
+		},
+		{
+			Name:  "Offset",
+			First: map[string]interface{}{"start": "2021-05-26T05:00:00+02:00", "end": "2021-05-30T07:00:00-05:00"},
+			Other: map[string]interface{}{"start": "2021-05-26T03:00:00Z", "end": "2021-05-30T12:00:00Z"},
+			Equal: true,
+		},
+		{
+			Name:  "Fractional Seconds",
+			First: map[string]interface{}{"start": "2021-05-26T03:00:00.000Z", "end": "2021-05-30T12:00:00.000Z"},
+			Other: map[string]interface{}{"start": "2021-05-26T03:00:00Z", "end": "2021-05-30T12:00:00Z"},
+			Equal: true,
+		},
+		{
+			Name:  "Different",
+			First: map[string]interface{}{"start": "2021-05-26T03:00:00+02:00", "end": "2021-05-30T12:00:00Z"},
+			Other: map[string]interface{}{"start": "2021-05-26T03:00:00Z", "end": "2021-05-30T12:00:00Z"},
+			Equal: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		first := kubernetesClusterMaintenanceWindowNotAllowedHash(v.First)
+		other := kubernetesClusterMaintenanceWindowNotAllowedHash(v.Other)
+		if (first == other) != v.Equal {
+			t.Fatalf("expected the hashes to be equal (%t) for %q but got %d and %d", v.Equal, v.Name, first, other)
+		}
+	}
+}
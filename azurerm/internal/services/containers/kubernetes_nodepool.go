@@ -63,6 +63,10 @@ func SchemaDefaultNodePool() *schema.Schema {
 					Optional: true,
 				},
 
+				"kubelet_config": schemaNodePoolKubeletConfig(),
+
+				"linux_os_config": schemaNodePoolLinuxOSConfig("default_node_pool.0.linux_os_config"),
+
 				"enable_node_public_ip": {
 					Type:     schema.TypeBool,
 					Optional: true,
@@ -192,6 +196,8 @@ func ConvertDefaultNodePoolToAgentPool(input *[]containerservice.ManagedClusterA
 			ProximityPlacementGroupID: defaultCluster.ProximityPlacementGroupID,
 			AvailabilityZones:         defaultCluster.AvailabilityZones,
 			EnableNodePublicIP:        defaultCluster.EnableNodePublicIP,
+			KubeletConfig:             defaultCluster.KubeletConfig,
+			LinuxOSConfig:             defaultCluster.LinuxOSConfig,
 			ScaleSetPriority:          defaultCluster.ScaleSetPriority,
 			ScaleSetEvictionPolicy:    defaultCluster.ScaleSetEvictionPolicy,
 			SpotMaxPrice:              defaultCluster.SpotMaxPrice,
@@ -229,6 +235,8 @@ func ExpandDefaultNodePool(d *schema.ResourceData) (*[]containerservice.ManagedC
 		EnableAutoScaling:      utils.Bool(enableAutoScaling),
 		EnableNodePublicIP:     utils.Bool(raw["enable_node_public_ip"].(bool)),
 		EnableEncryptionAtHost: utils.Bool(raw["enable_host_encryption"].(bool)),
+		KubeletConfig:          expandNodePoolKubeletConfig(raw["kubelet_config"].([]interface{})),
+		LinuxOSConfig:          expandNodePoolLinuxOSConfig(raw["linux_os_config"].([]interface{})),
 		Name:                   utils.String(raw["name"].(string)),
 		NodeLabels:             nodeLabels,
 		NodeTaints:             nodeTaints,
@@ -437,12 +445,19 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 
 	upgradeSettings := flattenUpgradeSettings(agentPool.UpgradeSettings)
 
+	linuxOSConfig, err := flattenNodePoolLinuxOSConfig(agentPool.LinuxOSConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	return &[]interface{}{
 		map[string]interface{}{
 			"availability_zones":           availabilityZones,
 			"enable_auto_scaling":          enableAutoScaling,
 			"enable_node_public_ip":        enableNodePublicIP,
 			"enable_host_encryption":       enableHostEncryption,
+			"kubelet_config":               flattenNodePoolKubeletConfig(agentPool.KubeletConfig),
+			"linux_os_config":              linuxOSConfig,
 			"max_count":                    maxCount,
 			"max_pods":                     maxPods,
 			"min_count":                    minCount,
@@ -505,3 +520,653 @@ func findDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolProfil
 
 	return agentPool, nil
 }
+
+func schemaNodePoolKubeletConfig() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_unsafe_sysctls": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"container_log_max_files": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(2),
+				},
+
+				"container_log_max_size_mb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"cpu_cfs_quota_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  nodePoolDefaultCPUCfsQuotaEnabled,
+				},
+
+				"cpu_cfs_quota_period": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"cpu_manager_policy": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"none",
+						"static",
+					}, false),
+				},
+
+				"image_gc_high_threshold": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					Default:      nodePoolDefaultImageGcHighThreshold,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+
+				"image_gc_low_threshold": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					Default:      nodePoolDefaultImageGcLowThreshold,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+
+				"pod_max_pid": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"topology_manager_policy": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"none",
+						"best-effort",
+						"restricted",
+						"single-numa-node",
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+// schemaNodePoolLinuxOSConfig returns the schema for the `linux_os_config` block, where path is the
+// full path to this block - which is used to validate the fields which must be specified together
+func schemaNodePoolLinuxOSConfig(path string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"swap_file_size_mb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"sysctl_config": schemaNodePoolSysctlConfig(fmt.Sprintf("%s.0.sysctl_config", path)),
+
+				"transparent_huge_page_defrag": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"always",
+						"defer",
+						"defer+madvise",
+						"madvise",
+						"never",
+					}, false),
+				},
+
+				"transparent_huge_page_enabled": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"always",
+						"madvise",
+						"never",
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func schemaNodePoolSysctlConfig(path string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fs_aio_max_nr": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(65536, 6553500),
+				},
+
+				"fs_file_max": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(8192, 12000500),
+				},
+
+				"fs_inotify_max_user_watches": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(781250, 2097152),
+				},
+
+				"fs_nr_open": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(8192, 20000500),
+				},
+
+				"kernel_threads_max": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(20, 513785),
+				},
+
+				"net_core_netdev_max_backlog": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(1000, 3240000),
+				},
+
+				"net_core_optmem_max": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(20480, 4194304),
+				},
+
+				"net_core_rmem_default": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(212992, 134217728),
+				},
+
+				"net_core_rmem_max": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(212992, 134217728),
+				},
+
+				"net_core_somaxconn": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(4096, 3240000),
+				},
+
+				"net_core_wmem_default": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(212992, 134217728),
+				},
+
+				"net_core_wmem_max": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(212992, 134217728),
+				},
+
+				"net_ipv4_ip_local_port_range_max": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					RequiredWith: []string{fmt.Sprintf("%s.0.net_ipv4_ip_local_port_range_min", path)},
+					ValidateFunc: validation.IntBetween(1024, 60999),
+				},
+
+				"net_ipv4_ip_local_port_range_min": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					RequiredWith: []string{fmt.Sprintf("%s.0.net_ipv4_ip_local_port_range_max", path)},
+					ValidateFunc: validation.IntBetween(1024, 60999),
+				},
+
+				"net_ipv4_neigh_default_gc_thresh1": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(128, 80000),
+				},
+
+				"net_ipv4_neigh_default_gc_thresh2": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(512, 90000),
+				},
+
+				"net_ipv4_neigh_default_gc_thresh3": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(1024, 100000),
+				},
+
+				"net_ipv4_tcp_fin_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(5, 120),
+				},
+
+				"net_ipv4_tcp_keepalive_intvl": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(10, 75),
+				},
+
+				"net_ipv4_tcp_keepalive_probes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(1, 15),
+				},
+
+				"net_ipv4_tcp_keepalive_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(30, 432000),
+				},
+
+				"net_ipv4_tcp_max_syn_backlog": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(128, 3240000),
+				},
+
+				"net_ipv4_tcp_max_tw_buckets": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(8000, 1440000),
+				},
+
+				"net_ipv4_tcp_tw_reuse": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  nodePoolDefaultNetIpv4TCPTwReuse,
+				},
+
+				"net_netfilter_nf_conntrack_buckets": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(65536, 147456),
+				},
+
+				"net_netfilter_nf_conntrack_max": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(131072, 589824),
+				},
+
+				"vm_max_map_count": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(65530, 262144),
+				},
+
+				"vm_swappiness": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					Default:      nodePoolDefaultVMSwappiness,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+
+				"vm_vfs_cache_pressure": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					Default:      nodePoolDefaultVMVfsCachePressure,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+		},
+	}
+}
+
+// the zero value is valid for the following fields, so these default to the values used by Azure when they're
+// omitted - and are only sent when they differ from the default, so that an omitted field is never sent as a zero
+const (
+	nodePoolDefaultCPUCfsQuotaEnabled   = true
+	nodePoolDefaultImageGcHighThreshold = 85
+	nodePoolDefaultImageGcLowThreshold  = 80
+	nodePoolDefaultNetIpv4TCPTwReuse    = false
+	nodePoolDefaultVMSwappiness         = 60
+	nodePoolDefaultVMVfsCachePressure   = 100
+)
+
+func expandNodePoolKubeletConfig(input []interface{}) *containerservice.KubeletConfig {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &containerservice.KubeletConfig{
+		AllowedUnsafeSysctls: utils.ExpandStringSlice(raw["allowed_unsafe_sysctls"].(*schema.Set).List()),
+	}
+
+	if v := raw["cpu_cfs_quota_enabled"].(bool); v != nodePoolDefaultCPUCfsQuotaEnabled {
+		output.CPUCfsQuota = utils.Bool(v)
+	}
+
+	if v := raw["cpu_manager_policy"].(string); v != "" {
+		output.CPUManagerPolicy = utils.String(v)
+	}
+	if v := raw["cpu_cfs_quota_period"].(string); v != "" {
+		output.CPUCfsQuotaPeriod = utils.String(v)
+	}
+	if v := raw["image_gc_high_threshold"].(int); v != nodePoolDefaultImageGcHighThreshold {
+		output.ImageGcHighThreshold = utils.Int32(int32(v))
+	}
+	if v := raw["image_gc_low_threshold"].(int); v != nodePoolDefaultImageGcLowThreshold {
+		output.ImageGcLowThreshold = utils.Int32(int32(v))
+	}
+	if v := raw["topology_manager_policy"].(string); v != "" {
+		output.TopologyManagerPolicy = utils.String(v)
+	}
+	if v := raw["container_log_max_size_mb"].(int); v != 0 {
+		output.ContainerLogMaxSizeMB = utils.Int32(int32(v))
+	}
+	if v := raw["container_log_max_files"].(int); v != 0 {
+		output.ContainerLogMaxFiles = utils.Int32(int32(v))
+	}
+	if v := raw["pod_max_pid"].(int); v != 0 {
+		output.PodMaxPids = utils.Int32(int32(v))
+	}
+
+	return output
+}
+
+func expandNodePoolLinuxOSConfig(input []interface{}) *containerservice.LinuxOSConfig {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &containerservice.LinuxOSConfig{
+		Sysctls: expandNodePoolSysctlConfig(raw["sysctl_config"].([]interface{})),
+	}
+
+	if v := raw["transparent_huge_page_enabled"].(string); v != "" {
+		output.TransparentHugePageEnabled = utils.String(v)
+	}
+	if v := raw["transparent_huge_page_defrag"].(string); v != "" {
+		output.TransparentHugePageDefrag = utils.String(v)
+	}
+	if v := raw["swap_file_size_mb"].(int); v != 0 {
+		output.SwapFileSizeMB = utils.Int32(int32(v))
+	}
+
+	return output
+}
+
+func expandNodePoolSysctlConfig(input []interface{}) *containerservice.SysctlConfig {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &containerservice.SysctlConfig{}
+
+	if v := raw["net_ipv4_tcp_tw_reuse"].(bool); v != nodePoolDefaultNetIpv4TCPTwReuse {
+		output.NetIpv4TCPTwReuse = utils.Bool(v)
+	}
+
+	// the API represents the local port range as a single space-separated value e.g. `32768 60999`
+	// both of which are required (and validated to be set together)
+	portRangeMin := raw["net_ipv4_ip_local_port_range_min"].(int)
+	portRangeMax := raw["net_ipv4_ip_local_port_range_max"].(int)
+	if portRangeMin != 0 && portRangeMax != 0 {
+		output.NetIpv4IPLocalPortRange = utils.String(fmt.Sprintf("%d %d", portRangeMin, portRangeMax))
+	}
+
+	if v := raw["fs_aio_max_nr"].(int); v != 0 {
+		output.FsAioMaxNr = utils.Int32(int32(v))
+	}
+	if v := raw["fs_file_max"].(int); v != 0 {
+		output.FsFileMax = utils.Int32(int32(v))
+	}
+	if v := raw["fs_inotify_max_user_watches"].(int); v != 0 {
+		output.FsInotifyMaxUserWatches = utils.Int32(int32(v))
+	}
+	if v := raw["fs_nr_open"].(int); v != 0 {
+		output.FsNrOpen = utils.Int32(int32(v))
+	}
+	if v := raw["kernel_threads_max"].(int); v != 0 {
+		output.KernelThreadsMax = utils.Int32(int32(v))
+	}
+	if v := raw["net_core_netdev_max_backlog"].(int); v != 0 {
+		output.NetCoreNetdevMaxBacklog = utils.Int32(int32(v))
+	}
+	if v := raw["net_core_optmem_max"].(int); v != 0 {
+		output.NetCoreOptmemMax = utils.Int32(int32(v))
+	}
+	if v := raw["net_core_rmem_default"].(int); v != 0 {
+		output.NetCoreRmemDefault = utils.Int32(int32(v))
+	}
+	if v := raw["net_core_rmem_max"].(int); v != 0 {
+		output.NetCoreRmemMax = utils.Int32(int32(v))
+	}
+	if v := raw["net_core_somaxconn"].(int); v != 0 {
+		output.NetCoreSomaxconn = utils.Int32(int32(v))
+	}
+	if v := raw["net_core_wmem_default"].(int); v != 0 {
+		output.NetCoreWmemDefault = utils.Int32(int32(v))
+	}
+	if v := raw["net_core_wmem_max"].(int); v != 0 {
+		output.NetCoreWmemMax = utils.Int32(int32(v))
+	}
+	if v := raw["net_ipv4_neigh_default_gc_thresh1"].(int); v != 0 {
+		output.NetIpv4NeighDefaultGcThresh1 = utils.Int32(int32(v))
+	}
+	if v := raw["net_ipv4_neigh_default_gc_thresh2"].(int); v != 0 {
+		output.NetIpv4NeighDefaultGcThresh2 = utils.Int32(int32(v))
+	}
+	if v := raw["net_ipv4_neigh_default_gc_thresh3"].(int); v != 0 {
+		output.NetIpv4NeighDefaultGcThresh3 = utils.Int32(int32(v))
+	}
+	if v := raw["net_ipv4_tcp_fin_timeout"].(int); v != 0 {
+		output.NetIpv4TCPFinTimeout = utils.Int32(int32(v))
+	}
+	if v := raw["net_ipv4_tcp_keepalive_intvl"].(int); v != 0 {
+		output.NetIpv4TcpkeepaliveIntvl = utils.Int32(int32(v))
+	}
+	if v := raw["net_ipv4_tcp_keepalive_probes"].(int); v != 0 {
+		output.NetIpv4TCPKeepaliveProbes = utils.Int32(int32(v))
+	}
+	if v := raw["net_ipv4_tcp_keepalive_time"].(int); v != 0 {
+		output.NetIpv4TCPKeepaliveTime = utils.Int32(int32(v))
+	}
+	if v := raw["net_ipv4_tcp_max_syn_backlog"].(int); v != 0 {
+		output.NetIpv4TCPMaxSynBacklog = utils.Int32(int32(v))
+	}
+	if v := raw["net_ipv4_tcp_max_tw_buckets"].(int); v != 0 {
+		output.NetIpv4TCPMaxTwBuckets = utils.Int32(int32(v))
+	}
+	if v := raw["net_netfilter_nf_conntrack_buckets"].(int); v != 0 {
+		output.NetNetfilterNfConntrackBuckets = utils.Int32(int32(v))
+	}
+	if v := raw["net_netfilter_nf_conntrack_max"].(int); v != 0 {
+		output.NetNetfilterNfConntrackMax = utils.Int32(int32(v))
+	}
+	if v := raw["vm_max_map_count"].(int); v != 0 {
+		output.VMMaxMapCount = utils.Int32(int32(v))
+	}
+	if v := raw["vm_swappiness"].(int); v != nodePoolDefaultVMSwappiness {
+		output.VMSwappiness = utils.Int32(int32(v))
+	}
+	if v := raw["vm_vfs_cache_pressure"].(int); v != nodePoolDefaultVMVfsCachePressure {
+		output.VMVfsCachePressure = utils.Int32(int32(v))
+	}
+
+	return output
+}
+
+func flattenNodePoolKubeletConfig(input *containerservice.KubeletConfig) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	cpuCfsQuotaEnabled := nodePoolDefaultCPUCfsQuotaEnabled
+	if input.CPUCfsQuota != nil {
+		cpuCfsQuotaEnabled = *input.CPUCfsQuota
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allowed_unsafe_sysctls":    utils.FlattenStringSlice(input.AllowedUnsafeSysctls),
+			"container_log_max_files":   int32Value(input.ContainerLogMaxFiles),
+			"container_log_max_size_mb": int32Value(input.ContainerLogMaxSizeMB),
+			"cpu_cfs_quota_enabled":     cpuCfsQuotaEnabled,
+			"cpu_cfs_quota_period":      utils.NormalizeNilableString(input.CPUCfsQuotaPeriod),
+			"cpu_manager_policy":        utils.NormalizeNilableString(input.CPUManagerPolicy),
+			"image_gc_high_threshold":   int32ValueOrDefault(input.ImageGcHighThreshold, nodePoolDefaultImageGcHighThreshold),
+			"image_gc_low_threshold":    int32ValueOrDefault(input.ImageGcLowThreshold, nodePoolDefaultImageGcLowThreshold),
+			"pod_max_pid":               int32Value(input.PodMaxPids),
+			"topology_manager_policy":   utils.NormalizeNilableString(input.TopologyManagerPolicy),
+		},
+	}
+}
+
+func flattenNodePoolLinuxOSConfig(input *containerservice.LinuxOSConfig) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	sysctlConfig, err := flattenNodePoolSysctlConfig(input.Sysctls)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"swap_file_size_mb":             int32Value(input.SwapFileSizeMB),
+			"sysctl_config":                 sysctlConfig,
+			"transparent_huge_page_defrag":  utils.NormalizeNilableString(input.TransparentHugePageDefrag),
+			"transparent_huge_page_enabled": utils.NormalizeNilableString(input.TransparentHugePageEnabled),
+		},
+	}, nil
+}
+
+func flattenNodePoolSysctlConfig(input *containerservice.SysctlConfig) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	netIpv4TCPTwReuse := nodePoolDefaultNetIpv4TCPTwReuse
+	if input.NetIpv4TCPTwReuse != nil {
+		netIpv4TCPTwReuse = *input.NetIpv4TCPTwReuse
+	}
+
+	portRangeMin := 0
+	portRangeMax := 0
+	if input.NetIpv4IPLocalPortRange != nil && *input.NetIpv4IPLocalPortRange != "" {
+		if _, err := fmt.Sscanf(*input.NetIpv4IPLocalPortRange, "%d %d", &portRangeMin, &portRangeMax); err != nil {
+			return nil, fmt.Errorf("parsing `netIpv4IpLocalPortRange` %q: %+v", *input.NetIpv4IPLocalPortRange, err)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"fs_aio_max_nr":                      int32Value(input.FsAioMaxNr),
+			"fs_file_max":                        int32Value(input.FsFileMax),
+			"fs_inotify_max_user_watches":        int32Value(input.FsInotifyMaxUserWatches),
+			"fs_nr_open":                         int32Value(input.FsNrOpen),
+			"kernel_threads_max":                 int32Value(input.KernelThreadsMax),
+			"net_core_netdev_max_backlog":        int32Value(input.NetCoreNetdevMaxBacklog),
+			"net_core_optmem_max":                int32Value(input.NetCoreOptmemMax),
+			"net_core_rmem_default":              int32Value(input.NetCoreRmemDefault),
+			"net_core_rmem_max":                  int32Value(input.NetCoreRmemMax),
+			"net_core_somaxconn":                 int32Value(input.NetCoreSomaxconn),
+			"net_core_wmem_default":              int32Value(input.NetCoreWmemDefault),
+			"net_core_wmem_max":                  int32Value(input.NetCoreWmemMax),
+			"net_ipv4_ip_local_port_range_max":   portRangeMax,
+			"net_ipv4_ip_local_port_range_min":   portRangeMin,
+			"net_ipv4_neigh_default_gc_thresh1":  int32Value(input.NetIpv4NeighDefaultGcThresh1),
+			"net_ipv4_neigh_default_gc_thresh2":  int32Value(input.NetIpv4NeighDefaultGcThresh2),
+			"net_ipv4_neigh_default_gc_thresh3":  int32Value(input.NetIpv4NeighDefaultGcThresh3),
+			"net_ipv4_tcp_fin_timeout":           int32Value(input.NetIpv4TCPFinTimeout),
+			"net_ipv4_tcp_keepalive_intvl":       int32Value(input.NetIpv4TcpkeepaliveIntvl),
+			"net_ipv4_tcp_keepalive_probes":      int32Value(input.NetIpv4TCPKeepaliveProbes),
+			"net_ipv4_tcp_keepalive_time":        int32Value(input.NetIpv4TCPKeepaliveTime),
+			"net_ipv4_tcp_max_syn_backlog":       int32Value(input.NetIpv4TCPMaxSynBacklog),
+			"net_ipv4_tcp_max_tw_buckets":        int32Value(input.NetIpv4TCPMaxTwBuckets),
+			"net_ipv4_tcp_tw_reuse":              netIpv4TCPTwReuse,
+			"net_netfilter_nf_conntrack_buckets": int32Value(input.NetNetfilterNfConntrackBuckets),
+			"net_netfilter_nf_conntrack_max":     int32Value(input.NetNetfilterNfConntrackMax),
+			"vm_max_map_count":                   int32Value(input.VMMaxMapCount),
+			"vm_swappiness":                      int32ValueOrDefault(input.VMSwappiness, nodePoolDefaultVMSwappiness),
+			"vm_vfs_cache_pressure":              int32ValueOrDefault(input.VMVfsCachePressure, nodePoolDefaultVMVfsCachePressure),
+		},
+	}, nil
+}
+
+func int32Value(input *int32) int {
+	return int32ValueOrDefault(input, 0)
+}
+
+func int32ValueOrDefault(input *int32, defaultValue int) int {
+	if input == nil {
+		return defaultValue
+	}
+
+	return int(*input)
+}
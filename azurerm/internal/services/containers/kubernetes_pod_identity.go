@@ -0,0 +1,250 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-12-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	msiparse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
+	msivalidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func schemaKubernetesClusterPodIdentityProfile() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		// the API retains the profile once Pod Identity has been configured
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"user_assigned_identity": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"namespace": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"identity_id": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: msivalidate.UserAssignedIdentityID,
+							},
+
+							"client_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+
+							"object_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+
+				"exception": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"namespace": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"pod_labels": {
+								Type:     schema.TypeMap,
+								Required: true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandKubernetesClusterPodIdentityProfile(input []interface{}) *containerservice.ManagedClusterPodIdentityProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	identities := make([]containerservice.ManagedClusterPodIdentity, 0)
+	for _, item := range raw["user_assigned_identity"].([]interface{}) {
+		v := item.(map[string]interface{})
+		identities = append(identities, containerservice.ManagedClusterPodIdentity{
+			Name:      utils.String(v["name"].(string)),
+			Namespace: utils.String(v["namespace"].(string)),
+			Identity: &containerservice.UserAssignedIdentity{
+				ResourceID: utils.String(v["identity_id"].(string)),
+			},
+		})
+	}
+
+	exceptions := make([]containerservice.ManagedClusterPodIdentityException, 0)
+	for _, item := range raw["exception"].([]interface{}) {
+		v := item.(map[string]interface{})
+		exceptions = append(exceptions, containerservice.ManagedClusterPodIdentityException{
+			Name:      utils.String(v["name"].(string)),
+			Namespace: utils.String(v["namespace"].(string)),
+			PodLabels: utils.ExpandMapStringPtrString(v["pod_labels"].(map[string]interface{})),
+		})
+	}
+
+	return &containerservice.ManagedClusterPodIdentityProfile{
+		Enabled:                        utils.Bool(raw["enabled"].(bool)),
+		UserAssignedIdentities:         &identities,
+		UserAssignedIdentityExceptions: &exceptions,
+	}
+}
+
+// populateKubernetesClusterPodIdentityProfile looks up the Client ID and Object ID for each of the
+// User Assigned Identities within the Pod Identity Profile, since the API requires all three values
+// but only the Resource ID is specified by users
+func populateKubernetesClusterPodIdentityProfile(ctx context.Context, client *msi.UserAssignedIdentitiesClient, profile *containerservice.ManagedClusterPodIdentityProfile) error {
+	if profile == nil || profile.UserAssignedIdentities == nil {
+		return nil
+	}
+
+	for i, item := range *profile.UserAssignedIdentities {
+		if item.Identity == nil || item.Identity.ResourceID == nil {
+			continue
+		}
+
+		id, err := msiparse.UserAssignedIdentityID(*item.Identity.ResourceID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving User Assigned Identity %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+		if resp.UserAssignedIdentityProperties == nil || resp.UserAssignedIdentityProperties.ClientID == nil || resp.UserAssignedIdentityProperties.PrincipalID == nil {
+			return fmt.Errorf("retrieving User Assigned Identity %q (Resource Group %q): `clientId` and `principalId` were nil", id.Name, id.ResourceGroup)
+		}
+
+		clientId := resp.UserAssignedIdentityProperties.ClientID.String()
+		objectId := resp.UserAssignedIdentityProperties.PrincipalID.String()
+		(*profile.UserAssignedIdentities)[i].Identity.ClientID = utils.String(clientId)
+		(*profile.UserAssignedIdentities)[i].Identity.ObjectID = utils.String(objectId)
+	}
+
+	return nil
+}
+
+func flattenKubernetesClusterPodIdentityProfile(input *containerservice.ManagedClusterPodIdentityProfile) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	identities := make([]interface{}, 0)
+	if input.UserAssignedIdentities != nil {
+		for _, item := range *input.UserAssignedIdentities {
+			name := ""
+			if item.Name != nil {
+				name = *item.Name
+			}
+
+			namespace := ""
+			if item.Namespace != nil {
+				namespace = *item.Namespace
+			}
+
+			identityId := ""
+			clientId := ""
+			objectId := ""
+			if item.Identity != nil {
+				if item.Identity.ResourceID != nil {
+					id, err := msiparse.UserAssignedIdentityID(*item.Identity.ResourceID)
+					if err != nil {
+						return nil, err
+					}
+					identityId = id.ID()
+				}
+
+				if item.Identity.ClientID != nil {
+					clientId = *item.Identity.ClientID
+				}
+
+				if item.Identity.ObjectID != nil {
+					objectId = *item.Identity.ObjectID
+				}
+			}
+
+			identities = append(identities, map[string]interface{}{
+				"name":        name,
+				"namespace":   namespace,
+				"identity_id": identityId,
+				"client_id":   clientId,
+				"object_id":   objectId,
+			})
+		}
+	}
+
+	exceptions := make([]interface{}, 0)
+	if input.UserAssignedIdentityExceptions != nil {
+		for _, item := range *input.UserAssignedIdentityExceptions {
+			name := ""
+			if item.Name != nil {
+				name = *item.Name
+			}
+
+			namespace := ""
+			if item.Namespace != nil {
+				namespace = *item.Namespace
+			}
+
+			exceptions = append(exceptions, map[string]interface{}{
+				"name":       name,
+				"namespace":  namespace,
+				"pod_labels": utils.FlattenMapStringPtrString(item.PodLabels),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":                enabled,
+			"user_assigned_identity": identities,
+			"exception":              exceptions,
+		},
+	}, nil
+}
@@ -0,0 +1,146 @@
+package containers
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-12-01/containerservice"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/roundtrip"
+)
+
+func TestKubernetesClusterAddOnProfilesRoundTrip(t *testing.T) {
+	// omitting the `addon_profile` block (or every add-on within it) disables the default add-ons and
+	// the block is Computed, so only configurations which specify at least one add-on round-trip
+	addOnProfiles := schemaKubernetesAddOnProfiles()
+	addOnProfiles.MinItems = 1
+	addOns := addOnProfiles.Elem.(*schema.Resource).Schema
+	keys := make([]string, 0)
+	for k := range addOns {
+		keys = append(keys, fmt.Sprintf("addon_profile.0.%s", k))
+	}
+	for _, v := range addOns {
+		v.AtLeastOneOf = keys
+	}
+
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: addOnProfiles,
+		Expand: func(input interface{}) (interface{}, error) {
+			return expandKubernetesAddOnProfiles(input.([]interface{}), azure.PublicCloud)
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenKubernetesAddOnProfiles(*input.(*map[string]*containerservice.ManagedClusterAddonProfile)), nil
+		},
+		Values: map[string]roundtrip.ValueFunc{
+			"ingress_application_gateway.gateway_id": func(r *rand.Rand) interface{} {
+				return fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group%d/providers/Microsoft.Network/applicationGateways/gateway%d", r.Intn(100), r.Intn(100))
+			},
+			"ingress_application_gateway.subnet_id": func(r *rand.Rand) interface{} {
+				return fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group%d/providers/Microsoft.Network/virtualNetworks/network%d/subnets/subnet%d", r.Intn(100), r.Intn(100), r.Intn(100))
+			},
+			"oms_agent.log_analytics_workspace_id": func(r *rand.Rand) interface{} {
+				return fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group%d/providers/Microsoft.OperationalInsights/workspaces/workspace%d", r.Intn(100), r.Intn(100))
+			},
+		},
+	})
+}
+
+func TestKubernetesClusterAutoScalerProfileRoundTrip(t *testing.T) {
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: resourceKubernetesCluster().Schema["auto_scaler_profile"],
+		Expand: func(input interface{}) (interface{}, error) {
+			return expandKubernetesClusterAutoScalerProfile(input.([]interface{})), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenKubernetesClusterAutoScalerProfile(input.(*containerservice.ManagedClusterPropertiesAutoScalerProfile)), nil
+		},
+	})
+}
+
+func TestKubernetesClusterLinuxProfileRoundTrip(t *testing.T) {
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: resourceKubernetesCluster().Schema["linux_profile"],
+		Expand: func(input interface{}) (interface{}, error) {
+			return expandKubernetesClusterLinuxProfile(input.([]interface{})), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenKubernetesClusterLinuxProfile(input.(*containerservice.LinuxProfile)), nil
+		},
+	})
+}
+
+func TestKubernetesClusterMaintenanceWindowRoundTrip(t *testing.T) {
+	randomTime := func(r *rand.Rand) interface{} {
+		return time.Unix(r.Int63n(4102444800), 0).UTC().Format(time.RFC3339)
+	}
+
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: schemaKubernetesClusterMaintenanceWindow(),
+		Expand: func(input interface{}) (interface{}, error) {
+			return expandKubernetesClusterMaintenanceConfiguration(input.([]interface{})), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenKubernetesClusterMaintenanceConfiguration(input.(*containerservice.MaintenanceConfigurationProperties)), nil
+		},
+		Values: map[string]roundtrip.ValueFunc{
+			"not_allowed.start": randomTime,
+			"not_allowed.end":   randomTime,
+		},
+	})
+}
+
+func TestKubernetesClusterPodIdentityProfileRoundTrip(t *testing.T) {
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: schemaKubernetesClusterPodIdentityProfile(),
+		Expand: func(input interface{}) (interface{}, error) {
+			return expandKubernetesClusterPodIdentityProfile(input.([]interface{})), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenKubernetesClusterPodIdentityProfile(input.(*containerservice.ManagedClusterPodIdentityProfile))
+		},
+		Values: map[string]roundtrip.ValueFunc{
+			"user_assigned_identity.identity_id": func(r *rand.Rand) interface{} {
+				return fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group%d/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity%d", r.Intn(100), r.Intn(100))
+			},
+		},
+	})
+}
+
+func TestKubernetesClusterNodePoolUpgradeSettingsRoundTrip(t *testing.T) {
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: upgradeSettingsSchema(),
+		Expand: func(input interface{}) (interface{}, error) {
+			return expandUpgradeSettings(input.([]interface{})), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenUpgradeSettings(input.(*containerservice.AgentPoolUpgradeSettings)), nil
+		},
+	})
+}
+
+func TestKubernetesClusterNodePoolKubeletConfigRoundTrip(t *testing.T) {
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: schemaNodePoolKubeletConfig(),
+		Expand: func(input interface{}) (interface{}, error) {
+			return expandNodePoolKubeletConfig(input.([]interface{})), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenNodePoolKubeletConfig(input.(*containerservice.KubeletConfig)), nil
+		},
+	})
+}
+
+func TestKubernetesClusterNodePoolLinuxOSConfigRoundTrip(t *testing.T) {
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: schemaNodePoolLinuxOSConfig("linux_os_config"),
+		Expand: func(input interface{}) (interface{}, error) {
+			return expandNodePoolLinuxOSConfig(input.([]interface{})), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenNodePoolLinuxOSConfig(input.(*containerservice.LinuxOSConfig))
+		},
+	})
+}
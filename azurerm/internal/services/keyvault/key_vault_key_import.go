@@ -0,0 +1,308 @@
+package keyvault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// keyVaultKeyImportFields are the fields within the `key_material` block containing the key material
+var keyVaultKeyImportFields = []string{"pem", "jwk", "hsm_byok_blob"}
+
+func schemaKeyVaultKeyImport() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"key_size", "curve"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"pem": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					StateFunc:    keyVaultKeyImportMaterialStateFunc,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ExactlyOneOf: []string{"key_material.0.pem", "key_material.0.jwk", "key_material.0.hsm_byok_blob"},
+				},
+
+				"jwk": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					StateFunc:    keyVaultKeyImportMaterialStateFunc,
+					ValidateFunc: validation.StringIsJSON,
+					ExactlyOneOf: []string{"key_material.0.pem", "key_material.0.jwk", "key_material.0.hsm_byok_blob"},
+				},
+
+				"hsm_byok_blob": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					StateFunc:    keyVaultKeyImportMaterialStateFunc,
+					ValidateFunc: validation.StringIsBase64,
+					ExactlyOneOf: []string{"key_material.0.pem", "key_material.0.jwk", "key_material.0.hsm_byok_blob"},
+				},
+			},
+		},
+	}
+}
+
+// expandKeyVaultKeyImportMaterial builds the JSON Web Key which should be imported into the Key Vault from the
+// `key_material` block - the returned key contains the private components and so must never be logged
+func expandKeyVaultKeyImportMaterial(input []interface{}, keyType keyvault.JSONWebKeyType) (*keyvault.JSONWebKey, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	var key *keyvault.JSONWebKey
+	var err error
+	if v := raw["pem"].(string); v != "" {
+		key, err = parseKeyVaultKeyImportPEM(v)
+	} else if v := raw["jwk"].(string); v != "" {
+		key, err = parseKeyVaultKeyImportJWK(v)
+	} else if v := raw["hsm_byok_blob"].(string); v != "" {
+		if keyType != keyvault.RSAHSM && keyType != keyvault.ECHSM {
+			return nil, fmt.Errorf("`hsm_byok_blob` can only be imported when `key_type` is %q or %q", string(keyvault.RSAHSM), string(keyvault.ECHSM))
+		}
+
+		blob, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, fmt.Errorf("decoding `hsm_byok_blob`: %+v", err)
+		}
+
+		return &keyvault.JSONWebKey{
+			Kty: keyType,
+			T:   utils.String(base64.RawURLEncoding.EncodeToString(blob)),
+		}, nil
+	} else {
+		return nil, fmt.Errorf("one of `pem`, `jwk` or `hsm_byok_blob` must be specified within the `key_material` block")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the Key Type within the key material is the software type, the HSM-ness is determined by the `key_type`
+	switch keyType {
+	case keyvault.RSA, keyvault.RSAHSM:
+		if key.Kty != keyvault.RSA {
+			return nil, fmt.Errorf("the `key_material` contains a key of type %q but `key_type` is %q", string(key.Kty), string(keyType))
+		}
+	case keyvault.EC, keyvault.ECHSM:
+		if key.Kty != keyvault.EC {
+			return nil, fmt.Errorf("the `key_material` contains a key of type %q but `key_type` is %q", string(key.Kty), string(keyType))
+		}
+	default:
+		return nil, fmt.Errorf("importing keys of type %q is not supported", string(keyType))
+	}
+	key.Kty = keyType
+
+	return key, nil
+}
+
+func parseKeyVaultKeyImportPEM(input string) (*keyvault.JSONWebKey, error) {
+	block, _ := pem.Decode([]byte(input))
+	if block == nil {
+		return nil, fmt.Errorf("`pem` does not contain a PEM encoded block")
+	}
+
+	var privateKey interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		privateKey, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("`pem` contains a block of type %q but only private keys can be imported", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing `pem`: %+v", err)
+	}
+
+	switch v := privateKey.(type) {
+	case *rsa.PrivateKey:
+		v.Precompute()
+		if len(v.Primes) != 2 {
+			return nil, fmt.Errorf("`pem` contains a multi-prime RSA key which cannot be imported")
+		}
+
+		return &keyvault.JSONWebKey{
+			Kty: keyvault.RSA,
+			N:   encodeKeyVaultKeyComponent(v.N),
+			E:   encodeKeyVaultKeyComponent(big.NewInt(int64(v.E))),
+			D:   encodeKeyVaultKeyComponent(v.D),
+			P:   encodeKeyVaultKeyComponent(v.Primes[0]),
+			Q:   encodeKeyVaultKeyComponent(v.Primes[1]),
+			DP:  encodeKeyVaultKeyComponent(v.Precomputed.Dp),
+			DQ:  encodeKeyVaultKeyComponent(v.Precomputed.Dq),
+			QI:  encodeKeyVaultKeyComponent(v.Precomputed.Qinv),
+		}, nil
+
+	case *ecdsa.PrivateKey:
+		var curve keyvault.JSONWebKeyCurveName
+		switch v.Curve {
+		case elliptic.P256():
+			curve = keyvault.P256
+		case elliptic.P384():
+			curve = keyvault.P384
+		case elliptic.P521():
+			curve = keyvault.P521
+		default:
+			return nil, fmt.Errorf("`pem` contains an EC key using the unsupported curve %q", v.Curve.Params().Name)
+		}
+
+		// EC components are padded to the size of the curve, as required by RFC 7518
+		size := (v.Curve.Params().BitSize + 7) / 8
+		return &keyvault.JSONWebKey{
+			Kty: keyvault.EC,
+			Crv: curve,
+			X:   utils.String(base64.RawURLEncoding.EncodeToString(padKeyVaultKeyComponent(v.X.Bytes(), size))),
+			Y:   utils.String(base64.RawURLEncoding.EncodeToString(padKeyVaultKeyComponent(v.Y.Bytes(), size))),
+			D:   utils.String(base64.RawURLEncoding.EncodeToString(padKeyVaultKeyComponent(v.D.Bytes(), size))),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("`pem` contains an unsupported key type %T", privateKey)
+}
+
+func parseKeyVaultKeyImportJWK(input string) (*keyvault.JSONWebKey, error) {
+	var key keyvault.JSONWebKey
+	if err := json.Unmarshal([]byte(input), &key); err != nil {
+		return nil, fmt.Errorf("parsing `jwk`: %+v", err)
+	}
+
+	// any key operations/identifier in the JWK are ignored in favour of the values defined in the resource
+	key.KeyOps = nil
+	key.Kid = nil
+
+	switch key.Kty {
+	case keyvault.RSA:
+		if key.N == nil || key.E == nil || key.D == nil {
+			return nil, fmt.Errorf("`jwk` must contain the `n`, `e` and `d` components of an RSA private key")
+		}
+	case keyvault.EC:
+		if key.Crv == "" || key.X == nil || key.Y == nil || key.D == nil {
+			return nil, fmt.Errorf("`jwk` must contain the `crv`, `x`, `y` and `d` components of an EC private key")
+		}
+	default:
+		return nil, fmt.Errorf("`jwk` contains a key of type %q but only %q and %q keys can be imported", string(key.Kty), string(keyvault.RSA), string(keyvault.EC))
+	}
+
+	return &key, nil
+}
+
+// verifyKeyVaultKeyImportedComponents confirms the public components of the key held in the Key Vault match those
+// of the key material which was imported
+func verifyKeyVaultKeyImportedComponents(expected keyvault.JSONWebKey, actual *keyvault.JSONWebKey) error {
+	if actual == nil {
+		return fmt.Errorf("the imported key was nil")
+	}
+
+	components := []struct {
+		name     string
+		expected *string
+		actual   *string
+	}{
+		{name: "n", expected: expected.N, actual: actual.N},
+		{name: "e", expected: expected.E, actual: actual.E},
+		{name: "x", expected: expected.X, actual: actual.X},
+		{name: "y", expected: expected.Y, actual: actual.Y},
+	}
+	for _, c := range components {
+		if c.expected == nil {
+			continue
+		}
+
+		if c.actual == nil || !keyVaultKeyComponentsMatch(*c.expected, *c.actual) {
+			return fmt.Errorf("the `%s` component of the imported key does not match the `key_material`", c.name)
+		}
+	}
+
+	return nil
+}
+
+// keyVaultKeyImportMaterialStateFunc hashes the key material, so that the private key isn't stored in the state
+func keyVaultKeyImportMaterialStateFunc(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		if s == "" {
+			return ""
+		}
+
+		hash := sha256.Sum256([]byte(s))
+		return hex.EncodeToString(hash[:])
+	default:
+		return ""
+	}
+}
+
+// keyVaultKeyImportMaterialChanged returns whether the key material within the `key_material` block (`new`)
+// differs from the hash of the key material which was last imported (`old`) - in which case a new version of
+// the key should be imported. Removing the key material doesn't result in a new version of the key.
+func keyVaultKeyImportMaterialChanged(old, new []interface{}) bool {
+	if len(new) == 0 || new[0] == nil {
+		return false
+	}
+	newRaw := new[0].(map[string]interface{})
+
+	oldRaw := make(map[string]interface{})
+	if len(old) > 0 && old[0] != nil {
+		oldRaw = old[0].(map[string]interface{})
+	}
+
+	for _, field := range keyVaultKeyImportFields {
+		oldHash, _ := oldRaw[field].(string)
+		newValue, _ := newRaw[field].(string)
+		if oldHash != keyVaultKeyImportMaterialStateFunc(newValue) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func keyVaultKeyComponentsMatch(first, second string) bool {
+	// the components are base64url encoded, however padding and leading zeros can differ between encoders
+	firstBytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(first, "="))
+	if err != nil {
+		return false
+	}
+	secondBytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(second, "="))
+	if err != nil {
+		return false
+	}
+
+	return new(big.Int).SetBytes(firstBytes).Cmp(new(big.Int).SetBytes(secondBytes)) == 0
+}
+
+func encodeKeyVaultKeyComponent(input *big.Int) *string {
+	return utils.String(base64.RawURLEncoding.EncodeToString(input.Bytes()))
+}
+
+func padKeyVaultKeyComponent(input []byte, size int) []byte {
+	if len(input) >= size {
+		return input
+	}
+
+	output := make([]byte, size)
+	copy(output[size-len(input):], input)
+	return output
+}
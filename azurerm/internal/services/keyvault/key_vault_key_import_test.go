@@ -0,0 +1,241 @@
+package keyvault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandKeyVaultKeyImportMaterial_RSA(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %+v", err)
+	}
+	expected := keyvault.JSONWebKey{
+		N: utils.String(base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes())),
+		E: utils.String("AQAB"),
+	}
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("marshalling RSA key: %+v", err)
+	}
+	inputs := map[string]string{
+		"pkcs1": string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+		"pkcs8": string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})),
+	}
+
+	for name, input := range inputs {
+		t.Logf("[DEBUG] Testing %q..", name)
+
+		key, err := expandKeyVaultKeyImportMaterial([]interface{}{map[string]interface{}{"pem": input, "jwk": "", "hsm_byok_blob": ""}}, keyvault.RSAHSM)
+		if err != nil {
+			t.Fatalf("expanding %q: %+v", name, err)
+		}
+
+		if key.Kty != keyvault.RSAHSM {
+			t.Fatalf("expected the Key Type to be %q but got %q", string(keyvault.RSAHSM), string(key.Kty))
+		}
+		if key.D == nil || key.P == nil || key.Q == nil || key.DP == nil || key.DQ == nil || key.QI == nil {
+			t.Fatalf("expected the private components of %q to be populated", name)
+		}
+		if err := verifyKeyVaultKeyImportedComponents(expected, key); err != nil {
+			t.Fatalf("verifying %q: %+v", name, err)
+		}
+	}
+
+	if _, err := expandKeyVaultKeyImportMaterial([]interface{}{map[string]interface{}{"pem": inputs["pkcs1"], "jwk": "", "hsm_byok_blob": ""}}, keyvault.EC); err == nil {
+		t.Fatalf("expected an error when importing an RSA key as an EC key")
+	}
+}
+
+func TestExpandKeyVaultKeyImportMaterial_EC(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating EC key: %+v", err)
+	}
+	ecBytes, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("marshalling EC key: %+v", err)
+	}
+	input := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecBytes}))
+
+	key, err := expandKeyVaultKeyImportMaterial([]interface{}{map[string]interface{}{"pem": input, "jwk": "", "hsm_byok_blob": ""}}, keyvault.EC)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+
+	if key.Crv != keyvault.P384 {
+		t.Fatalf("expected the Curve to be %q but got %q", string(keyvault.P384), string(key.Crv))
+	}
+	if len(*key.X) != base64.RawURLEncoding.EncodedLen(48) || len(*key.Y) != base64.RawURLEncoding.EncodedLen(48) {
+		t.Fatalf("expected the EC components to be padded to the curve size")
+	}
+
+	expected := keyvault.JSONWebKey{
+		X: utils.String(base64.RawURLEncoding.EncodeToString(privateKey.X.Bytes())),
+		Y: utils.String(base64.RawURLEncoding.EncodeToString(privateKey.Y.Bytes())),
+	}
+	if err := verifyKeyVaultKeyImportedComponents(expected, key); err != nil {
+		t.Fatalf("verifying: %+v", err)
+	}
+
+	// the JWK representation of the same key should expand to the same public components
+	jwk, err := json.Marshal(key)
+	if err != nil {
+		t.Fatalf("marshalling JWK: %+v", err)
+	}
+	fromJwk, err := expandKeyVaultKeyImportMaterial([]interface{}{map[string]interface{}{"pem": "", "jwk": string(jwk), "hsm_byok_blob": ""}}, keyvault.ECHSM)
+	if err != nil {
+		t.Fatalf("expanding JWK: %+v", err)
+	}
+	if err := verifyKeyVaultKeyImportedComponents(expected, fromJwk); err != nil {
+		t.Fatalf("verifying JWK: %+v", err)
+	}
+}
+
+func TestExpandKeyVaultKeyImportMaterial_HSMBYOKBlob(t *testing.T) {
+	input := map[string]interface{}{
+		"pem":           "",
+		"jwk":           "",
+		"hsm_byok_blob": base64.StdEncoding.EncodeToString([]byte("transfer-blob")),
+	}
+
+	if _, err := expandKeyVaultKeyImportMaterial([]interface{}{input}, keyvault.RSA); err == nil {
+		t.Fatalf("expected an error when importing an HSM BYOK blob as a software key")
+	}
+
+	key, err := expandKeyVaultKeyImportMaterial([]interface{}{input}, keyvault.RSAHSM)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+	if key.T == nil || *key.T != base64.RawURLEncoding.EncodeToString([]byte("transfer-blob")) {
+		t.Fatalf("expected the HSM token to be base64url encoded")
+	}
+}
+
+func TestVerifyKeyVaultKeyImportedComponents(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Expected keyvault.JSONWebKey
+		Actual   keyvault.JSONWebKey
+		Valid    bool
+	}{
+		{
+			Name:     "Matching",
+			Expected: keyvault.JSONWebKey{N: utils.String("AQID"), E: utils.String("AQAB")},
+			Actual:   keyvault.JSONWebKey{N: utils.String("AQID"), E: utils.String("AQAB")},
+			Valid:    true,
+		},
+		{
+			Name:     "Leading Zeros",
+			Expected: keyvault.JSONWebKey{N: utils.String("AQID")},
+			Actual:   keyvault.JSONWebKey{N: utils.String("AAECAw")},
+			Valid:    true,
+		},
+		{
+			Name:     "Mismatched",
+			Expected: keyvault.JSONWebKey{N: utils.String("AQID"), E: utils.String("AQAB")},
+			Actual:   keyvault.JSONWebKey{N: utils.String("AQIE"), E: utils.String("AQAB")},
+			Valid:    false,
+		},
+		{
+			Name:     "Missing",
+			Expected: keyvault.JSONWebKey{X: utils.String("AQID")},
+			Actual:   keyvault.JSONWebKey{},
+			Valid:    false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := verifyKeyVaultKeyImportedComponents(v.Expected, &v.Actual)
+		if v.Valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Name, err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected %q to be invalid", v.Name)
+		}
+	}
+}
+
+func TestKeyVaultKeyImportMaterialChanged(t *testing.T) {
+	hashed := func(pem, jwk, blob string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"pem":           keyVaultKeyImportMaterialStateFunc(pem),
+				"jwk":           keyVaultKeyImportMaterialStateFunc(jwk),
+				"hsm_byok_blob": keyVaultKeyImportMaterialStateFunc(blob),
+			},
+		}
+	}
+	raw := func(pem, jwk, blob string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"pem":           pem,
+				"jwk":           jwk,
+				"hsm_byok_blob": blob,
+			},
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Old      []interface{}
+		New      []interface{}
+		Expected bool
+	}{
+		{
+			Name:     "Added",
+			Old:      []interface{}{},
+			New:      raw("first", "", ""),
+			Expected: true,
+		},
+		{
+			Name:     "Unchanged",
+			Old:      hashed("first", "", ""),
+			New:      raw("first", "", ""),
+			Expected: false,
+		},
+		{
+			Name:     "Changed",
+			Old:      hashed("first", "", ""),
+			New:      raw("second", "", ""),
+			Expected: true,
+		},
+		{
+			Name:     "Changed Field",
+			Old:      hashed("first", "", ""),
+			New:      raw("", "first", ""),
+			Expected: true,
+		},
+		{
+			Name:     "Removed",
+			Old:      hashed("first", "", ""),
+			New:      []interface{}{},
+			Expected: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if actual := keyVaultKeyImportMaterialChanged(v.Old, v.New); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+
+	if hash := keyVaultKeyImportMaterialStateFunc("first"); hash == "first" || hash == "" {
+		t.Fatalf("expected the key material to be hashed but got %q", hash)
+	}
+}
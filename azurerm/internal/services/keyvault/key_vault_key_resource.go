@@ -73,6 +73,7 @@ func resourceKeyVaultKey() *schema.Resource {
 			"key_size": {
 				Type:          schema.TypeInt,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"curve"},
 			},
@@ -112,6 +113,8 @@ func resourceKeyVaultKey() *schema.Resource {
 				ConflictsWith: []string{"key_size"},
 			},
 
+			"key_material": schemaKeyVaultKeyImport(),
+
 			"not_before_date": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -189,49 +192,49 @@ func resourceKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurerm_key_vault_key", *existing.Key.Kid)
 	}
 
-	keyType := d.Get("key_type").(string)
+	keyType := keyvault.JSONWebKeyType(d.Get("key_type").(string))
 	keyOptions := expandKeyVaultKeyOptions(d)
 	t := d.Get("tags").(map[string]interface{})
 
-	// TODO: support Importing Keys once this is fixed:
-	// https://github.com/Azure/azure-rest-api-specs/issues/1747
-	parameters := keyvault.KeyCreateParameters{
-		Kty:    keyvault.JSONWebKeyType(keyType),
-		KeyOps: keyOptions,
-		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
-		},
-
-		Tags: tags.Expand(t),
+	keyMaterial, err := expandKeyVaultKeyImportMaterial(d.Get("key_material").([]interface{}), keyType)
+	if err != nil {
+		return fmt.Errorf("expanding `key_material` for Key %q: %+v", name, err)
 	}
 
-	if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
-		curveName := d.Get("curve").(string)
-		parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
-	} else if parameters.Kty == keyvault.RSA || parameters.Kty == keyvault.RSAHSM {
-		keySize, ok := d.GetOk("key_size")
-		if !ok {
-			return fmt.Errorf("Key size is required when creating an RSA key")
+	attributes := expandKeyVaultKeyAttributes(d)
+
+	createKey := func() (autorest.Response, error) {
+		if keyMaterial != nil {
+			return importKeyVaultKey(ctx, client, *keyVaultBaseUri, name, *keyMaterial, keyOptions, attributes, t)
 		}
-		parameters.KeySize = utils.Int32(int32(keySize.(int)))
-	}
-	// TODO: support `oct` once this is fixed
-	// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
 
-	if v, ok := d.GetOk("not_before_date"); ok {
-		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		notBeforeUnixTime := date.UnixTime(notBeforeDate)
-		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
-	}
+		parameters := keyvault.KeyCreateParameters{
+			Kty:           keyType,
+			KeyOps:        keyOptions,
+			KeyAttributes: attributes,
 
-	if v, ok := d.GetOk("expiration_date"); ok {
-		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		expirationUnixTime := date.UnixTime(expirationDate)
-		parameters.KeyAttributes.Expires = &expirationUnixTime
+			Tags: tags.Expand(t),
+		}
+
+		if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
+			curveName := d.Get("curve").(string)
+			parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
+		} else if parameters.Kty == keyvault.RSA || parameters.Kty == keyvault.RSAHSM {
+			keySize, ok := d.GetOk("key_size")
+			if !ok {
+				return autorest.Response{}, fmt.Errorf("Key size is required when creating an RSA key")
+			}
+			parameters.KeySize = utils.Int32(int32(keySize.(int)))
+		}
+		// TODO: support `oct` once this is fixed
+		// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
+
+		resp, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters)
+		return resp.Response, err
 	}
 
-	if resp, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters); err != nil {
-		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults && utils.ResponseWasConflict(resp.Response) {
+	if resp, err := createKey(); err != nil {
+		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults && utils.ResponseWasConflict(resp) {
 			recoveredKey, err := client.RecoverDeletedKey(ctx, *keyVaultBaseUri, name)
 			if err != nil {
 				return err
@@ -253,6 +256,13 @@ func resourceKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 				}
 				log.Printf("[DEBUG] Key %q recovered with ID: %q", name, *kid)
 			}
+
+			// the recovered key contains the previous key material, so the specified key material is imported as a new version
+			if keyMaterial != nil {
+				if _, err := importKeyVaultKey(ctx, client, *keyVaultBaseUri, name, *keyMaterial, keyOptions, attributes, t); err != nil {
+					return fmt.Errorf("importing Key %q into recovered Key: %+v", name, err)
+				}
+			}
 		} else {
 			return fmt.Errorf("Error Creating Key: %+v", err)
 		}
@@ -264,6 +274,12 @@ func resourceKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if keyMaterial != nil {
+		if err := verifyKeyVaultKeyImportedComponents(*keyMaterial, read.Key); err != nil {
+			return fmt.Errorf("verifying imported Key %q: %+v", name, err)
+		}
+	}
+
 	d.SetId(*read.Key.Kid)
 
 	return resourceKeyVaultKeyRead(d, meta)
@@ -307,24 +323,36 @@ func resourceKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	keyOptions := expandKeyVaultKeyOptions(d)
 	t := d.Get("tags").(map[string]interface{})
 
-	parameters := keyvault.KeyUpdateParameters{
-		KeyOps: keyOptions,
-		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
-		},
-		Tags: tags.Expand(t),
-	}
+	// the key material is stored in the state as a hash - so a new version of the key is only imported
+	// when this hash changes, and removing the key material doesn't result in a new version of the key
+	if old, new := d.GetChange("key_material"); keyVaultKeyImportMaterialChanged(old.([]interface{}), new.([]interface{})) {
+		keyMaterial, err := expandKeyVaultKeyImportMaterial(new.([]interface{}), keyvault.JSONWebKeyType(d.Get("key_type").(string)))
+		if err != nil {
+			return fmt.Errorf("expanding `key_material` for Key %q: %+v", id.Name, err)
+		}
 
-	if v, ok := d.GetOk("not_before_date"); ok {
-		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		notBeforeUnixTime := date.UnixTime(notBeforeDate)
-		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
+		if keyMaterial != nil {
+			if _, err := importKeyVaultKey(ctx, client, id.KeyVaultBaseUrl, id.Name, *keyMaterial, keyOptions, expandKeyVaultKeyAttributes(d), t); err != nil {
+				return fmt.Errorf("importing new version of Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+
+			read, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+			if err != nil {
+				return fmt.Errorf("retrieving Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+
+			if err := verifyKeyVaultKeyImportedComponents(*keyMaterial, read.Key); err != nil {
+				return fmt.Errorf("verifying imported Key %q: %+v", id.Name, err)
+			}
+
+			d.SetId(*read.Key.Kid)
+		}
 	}
 
-	if v, ok := d.GetOk("expiration_date"); ok {
-		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		expirationUnixTime := date.UnixTime(expirationDate)
-		parameters.KeyAttributes.Expires = &expirationUnixTime
+	parameters := keyvault.KeyUpdateParameters{
+		KeyOps:        keyOptions,
+		KeyAttributes: expandKeyVaultKeyAttributes(d),
+		Tags:          tags.Expand(t),
 	}
 
 	if _, err = client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
@@ -471,6 +499,24 @@ func resourceKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func importKeyVaultKey(ctx context.Context, client *keyvault.BaseClient, keyVaultUri, name string, key keyvault.JSONWebKey, keyOptions *[]keyvault.JSONWebKeyOperation, attributes *keyvault.KeyAttributes, t map[string]interface{}) (autorest.Response, error) {
+	keyOps := make([]string, 0, len(*keyOptions))
+	for _, option := range *keyOptions {
+		keyOps = append(keyOps, string(option))
+	}
+	key.KeyOps = &keyOps
+
+	parameters := keyvault.KeyImportParameters{
+		Hsm:           utils.Bool(key.Kty == keyvault.RSAHSM || key.Kty == keyvault.ECHSM),
+		Key:           &key,
+		KeyAttributes: attributes,
+		Tags:          tags.Expand(t),
+	}
+
+	resp, err := client.ImportKey(ctx, keyVaultUri, name, parameters)
+	return resp.Response, err
+}
+
 var _ deleteAndPurgeNestedItem = deleteAndPurgeKey{}
 
 type deleteAndPurgeKey struct {
@@ -509,6 +555,26 @@ func expandKeyVaultKeyOptions(d *schema.ResourceData) *[]keyvault.JSONWebKeyOper
 	return &results
 }
 
+func expandKeyVaultKeyAttributes(d *schema.ResourceData) *keyvault.KeyAttributes {
+	attributes := &keyvault.KeyAttributes{
+		Enabled: utils.Bool(true),
+	}
+
+	if v, ok := d.GetOk("not_before_date"); ok {
+		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		notBeforeUnixTime := date.UnixTime(notBeforeDate)
+		attributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		expirationUnixTime := date.UnixTime(expirationDate)
+		attributes.Expires = &expirationUnixTime
+	}
+
+	return attributes
+}
+
 func flattenKeyVaultKeyOptions(input *[]string) []interface{} {
 	results := make([]interface{}, 0, len(*input))
 
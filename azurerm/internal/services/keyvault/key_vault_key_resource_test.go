@@ -283,6 +283,49 @@ func TestAccKeyVaultKey_purge(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_importRSA(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.importRSA(data, "testdata/keyvault-key-import-rsa.pem"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_size").HasValue("2048"),
+				check.That(data.ResourceName).Key("n").Exists(),
+				check.That(data.ResourceName).Key("e").HasValue("AQAB"),
+			),
+		},
+		data.ImportStep("key_material"),
+		{
+			Config: r.importRSA(data, "testdata/keyvault-key-import-rsa-updated.pem"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_material"),
+	})
+}
+
+func TestAccKeyVaultKey_importEC(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.importEC(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("curve").HasValue("P-256"),
+				check.That(data.ResourceName).Key("x").Exists(),
+				check.That(data.ResourceName).Key("y").Exists(),
+			),
+		},
+		data.ImportStep("key_material"),
+	})
+}
+
 func (r KeyVaultKeyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.KeyVault.ManagementClient
 	keyVaultsClient := clients.KeyVault
@@ -763,6 +806,60 @@ resource "azurerm_key_vault_key" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString)
 }
 
+func (r KeyVaultKeyResource) importRSA(data acceptance.TestData, path string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+
+  key_material {
+    pem = file("%s")
+  }
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+`, r.templateStandard(data), data.RandomString, path)
+}
+
+func (r KeyVaultKeyResource) importEC(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "EC"
+
+  key_material {
+    pem = file("testdata/keyvault-key-import-ec.pem")
+  }
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+}
+`, r.templateStandard(data), data.RandomString)
+}
+
 func (r KeyVaultKeyResource) templateStandard(data acceptance.TestData) string {
 	return r.template(data, "standard")
 }
@@ -796,6 +893,7 @@ resource "azurerm_key_vault" "test" {
       "Create",
       "Delete",
       "Get",
+      "Import",
       "Purge",
       "Recover",
       "Update",
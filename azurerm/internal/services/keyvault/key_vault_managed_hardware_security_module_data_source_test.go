@@ -0,0 +1,44 @@
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type KeyVaultManagedHardwareSecurityModuleDataSource struct {
+}
+
+func TestAccDataSourceKeyVaultManagedHardwareSecurityModule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_managed_hardware_security_module", "test")
+	r := KeyVaultManagedHardwareSecurityModuleDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("hsm_uri").Exists(),
+				check.That(data.ResourceName).Key("sku_name").HasValue("Standard_B1"),
+				check.That(data.ResourceName).Key("tenant_id").Exists(),
+				check.That(data.ResourceName).Key("admin_object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("soft_delete_retention_days").HasValue("7"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_managed_hardware_security_module" "test" {
+  name                = azurerm_key_vault_managed_hardware_security_module.test.name
+  resource_group_name = azurerm_key_vault_managed_hardware_security_module.test.resource_group_name
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.basic(data))
+}
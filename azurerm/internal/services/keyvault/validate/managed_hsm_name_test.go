@@ -0,0 +1,62 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestValidateManagedHSMName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "hi",
+			ExpectError: true,
+		},
+		{
+			Input:       "hello",
+			ExpectError: false,
+		},
+		{
+			Input:       "hello-world-21",
+			ExpectError: false,
+		},
+		{
+			Input:       "hello_world_21",
+			ExpectError: true,
+		},
+		{
+			Input:       "Hello-World",
+			ExpectError: false,
+		},
+		{
+			Input:       "20202020",
+			ExpectError: true,
+		},
+		{
+			Input:       "hello-",
+			ExpectError: true,
+		},
+		{
+			Input:       "abcdefghijklmnopqrstuvwx",
+			ExpectError: false,
+		},
+		{
+			Input:       "abcdefghijklmnopqrstuvwxy",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ManagedHSMName(tc.Input, "")
+
+		hasError := len(errors) > 0
+		if tc.ExpectError != hasError {
+			t.Fatalf("Expected ManagedHSMName to return error %t for %q but got %t", tc.ExpectError, tc.Input, hasError)
+		}
+	}
+}
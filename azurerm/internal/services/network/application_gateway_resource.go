@@ -6,7 +6,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -3227,9 +3227,11 @@ func flattenApplicationGatewaySku(input *network.ApplicationGatewaySku) []interf
 
 	result["name"] = string(input.Name)
 	result["tier"] = string(input.Tier)
+	capacity := 0
 	if input.Capacity != nil {
-		result["capacity"] = int(*input.Capacity)
+		capacity = int(*input.Capacity)
 	}
+	result["capacity"] = capacity
 
 	return []interface{}{result}
 }
@@ -0,0 +1,82 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/roundtrip"
+)
+
+func TestApplicationGatewayAutoscaleConfigurationRoundTrip(t *testing.T) {
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: resourceApplicationGateway().Schema["autoscale_configuration"],
+		Expand: func(input interface{}) (interface{}, error) {
+			d, err := applicationGatewayResourceDataFor("autoscale_configuration", input)
+			if err != nil {
+				return nil, err
+			}
+			return expandApplicationGatewayAutoscaleConfiguration(d), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenApplicationGatewayAutoscaleConfiguration(input.(*network.ApplicationGatewayAutoscaleConfiguration)), nil
+		},
+	})
+}
+
+func TestApplicationGatewayCustomErrorConfigurationRoundTrip(t *testing.T) {
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: resourceApplicationGateway().Schema["custom_error_configuration"],
+		Expand: func(input interface{}) (interface{}, error) {
+			return expandApplicationGatewayCustomErrorConfigurations(input.([]interface{})), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenApplicationGatewayCustomErrorConfigurations(input.(*[]network.ApplicationGatewayCustomError)), nil
+		},
+	})
+}
+
+func TestApplicationGatewaySkuRoundTrip(t *testing.T) {
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: resourceApplicationGateway().Schema["sku"],
+		Expand: func(input interface{}) (interface{}, error) {
+			d, err := applicationGatewayResourceDataFor("sku", input)
+			if err != nil {
+				return nil, err
+			}
+			return expandApplicationGatewaySku(d), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenApplicationGatewaySku(input.(*network.ApplicationGatewaySku)), nil
+		},
+	})
+}
+
+func TestApplicationGatewayWafConfigurationRoundTrip(t *testing.T) {
+	roundtrip.Run(t, roundtrip.TestCase{
+		Schema: resourceApplicationGateway().Schema["waf_configuration"],
+		Expand: func(input interface{}) (interface{}, error) {
+			d, err := applicationGatewayResourceDataFor("waf_configuration", input)
+			if err != nil {
+				return nil, err
+			}
+			if len(d.Get("waf_configuration").([]interface{})) == 0 {
+				return (*network.ApplicationGatewayWebApplicationFirewallConfiguration)(nil), nil
+			}
+			return expandApplicationGatewayWafConfig(d), nil
+		},
+		Flatten: func(input interface{}) (interface{}, error) {
+			return flattenApplicationGatewayWafConfig(input.(*network.ApplicationGatewayWebApplicationFirewallConfiguration)), nil
+		},
+	})
+}
+
+// applicationGatewayResourceDataFor returns the ResourceData for an Application Gateway with the specified
+// field set, for the expand functions which retrieve their configuration from the ResourceData
+func applicationGatewayResourceDataFor(key string, input interface{}) (*schema.ResourceData, error) {
+	d := resourceApplicationGateway().TestResourceData()
+	if err := d.Set(key, input); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
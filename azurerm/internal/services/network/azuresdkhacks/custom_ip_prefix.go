@@ -0,0 +1,167 @@
+package azuresdkhacks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// the ownership validation properties are only available from this API Version onwards - so every
+// operation on a Custom IP Prefix uses this API Version, rather than mixing it with the vendored one
+const customIPPrefixAPIVersion = "2021-03-01"
+
+// CreateOrUpdateCustomIPPrefix works around the Network SDK not exposing the `signedMessage` and
+// `authorizationMessage` properties required to validate ownership of a Custom IP Prefix
+func CreateOrUpdateCustomIPPrefix(ctx context.Context, client *network.CustomIPPrefixesClient, resourceGroupName string, customIPPrefixName string, parameters network.CustomIPPrefix, signedMessage string, authorizationMessage string) (result network.CustomIPPrefixesCreateOrUpdateFuture, err error) {
+	parameters.Etag = nil
+	req, err := customIPPrefixPreparer(ctx, client, resourceGroupName, customIPPrefixName,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		withCustomIPPrefixOwnershipValidation(parameters, signedMessage, authorizationMessage))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.CustomIPPrefixesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.CustomIPPrefixesClient", "CreateOrUpdate", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetCustomIPPrefix retrieves the Custom IP Prefix using the same API Version as CreateOrUpdateCustomIPPrefix
+func GetCustomIPPrefix(ctx context.Context, client *network.CustomIPPrefixesClient, resourceGroupName string, customIPPrefixName string) (result network.CustomIPPrefix, err error) {
+	req, err := customIPPrefixPreparer(ctx, client, resourceGroupName, customIPPrefixName, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.CustomIPPrefixesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "network.CustomIPPrefixesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.CustomIPPrefixesClient", "Get", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UpdateCustomIPPrefixTags updates the Tags for the Custom IP Prefix using the same API Version as CreateOrUpdateCustomIPPrefix
+func UpdateCustomIPPrefixTags(ctx context.Context, client *network.CustomIPPrefixesClient, resourceGroupName string, customIPPrefixName string, parameters network.TagsObject) (result network.CustomIPPrefix, err error) {
+	req, err := customIPPrefixPreparer(ctx, client, resourceGroupName, customIPPrefixName,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.CustomIPPrefixesClient", "UpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.UpdateTagsSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "network.CustomIPPrefixesClient", "UpdateTags", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.UpdateTagsResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.CustomIPPrefixesClient", "UpdateTags", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// DeleteCustomIPPrefix deletes the Custom IP Prefix using the same API Version as CreateOrUpdateCustomIPPrefix
+func DeleteCustomIPPrefix(ctx context.Context, client *network.CustomIPPrefixesClient, resourceGroupName string, customIPPrefixName string) (result network.CustomIPPrefixesDeleteFuture, err error) {
+	req, err := customIPPrefixPreparer(ctx, client, resourceGroupName, customIPPrefixName, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.CustomIPPrefixesClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.DeleteSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.CustomIPPrefixesClient", "Delete", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+func customIPPrefixPreparer(ctx context.Context, client *network.CustomIPPrefixesClient, resourceGroupName string, customIPPrefixName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"customIpPrefixName": autorest.Encode("path", customIPPrefixName),
+		"resourceGroupName":  autorest.Encode("path", resourceGroupName),
+		"subscriptionId":     autorest.Encode("path", client.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": customIPPrefixAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/customIpPrefixes/{customIpPrefixName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func withCustomIPPrefixOwnershipValidation(v network.CustomIPPrefix, signedMessage string, authorizationMessage string) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			b, err := json.Marshal(v)
+			if err != nil {
+				return r, err
+			}
+
+			var out map[string]interface{}
+			if err := json.Unmarshal(b, &out); err != nil {
+				return r, err
+			}
+
+			props := make(map[string]interface{})
+			if existing, ok := out["properties"].(map[string]interface{}); ok {
+				props = existing
+			}
+			if signedMessage != "" {
+				props["signedMessage"] = signedMessage
+			}
+			if authorizationMessage != "" {
+				props["authorizationMessage"] = authorizationMessage
+			}
+			out["properties"] = props
+
+			b, err = json.Marshal(out)
+			if err != nil {
+				return r, err
+			}
+
+			r.ContentLength = int64(len(b))
+			r.Body = io.NopCloser(bytes.NewReader(b))
+			return r, nil
+		})
+	}
+}
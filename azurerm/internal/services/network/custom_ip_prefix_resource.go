@@ -0,0 +1,331 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceCustomIpPrefix() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomIpPrefixCreate,
+		Read:   resourceCustomIpPrefixRead,
+		Update: resourceCustomIpPrefixUpdate,
+		Delete: resourceCustomIpPrefixDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.CustomIpPrefixID(id)
+			return err
+		}),
+
+		// provisioning and (de)commissioning a range is slow - each transition can take several hours
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(9 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(9 * time.Hour),
+			Delete: schema.DefaultTimeout(9 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+
+			"signed_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"authorization_message"},
+			},
+
+			"authorization_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"signed_message"},
+			},
+
+			"commissioning_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"zones": azure.SchemaZones(),
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceCustomIpPrefixCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewCustomIpPrefixID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := azuresdkhacks.GetCustomIPPrefix(ctx, client, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_custom_ip_prefix", id.ID())
+	}
+
+	parameters := network.CustomIPPrefix{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		CustomIPPrefixPropertiesFormat: &network.CustomIPPrefixPropertiesFormat{
+			Cidr: utils.String(d.Get("cidr").(string)),
+		},
+		Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
+		Tags:  tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	signedMessage := d.Get("signed_message").(string)
+	authorizationMessage := d.Get("authorization_message").(string)
+
+	future, err := azuresdkhacks.CreateOrUpdateCustomIPPrefix(ctx, client, id.ResourceGroup, id.Name, parameters, signedMessage, authorizationMessage)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	// the ID is set prior to waiting for provisioning so that the resource is tracked (and can be deleted)
+	// should the validation of the range fail or time out
+	d.SetId(id.ID())
+
+	// the range is validated asynchronously once the resource has been created
+	if err := waitForCustomIpPrefixCommissionedState(ctx, client, id, []string{string(network.Provisioning)}, string(network.Provisioned)); err != nil {
+		return fmt.Errorf("waiting for %s to be provisioned: %+v", id, err)
+	}
+
+	if d.Get("commissioning_enabled").(bool) {
+		if err := updateCustomIpPrefixCommissionedState(ctx, client, id, true, signedMessage, authorizationMessage); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomIpPrefixRead(d, meta)
+}
+
+func resourceCustomIpPrefixRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := azuresdkhacks.GetCustomIPPrefix(ctx, client, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("zones", azure.FlattenZones(resp.Zones))
+
+	if props := resp.CustomIPPrefixPropertiesFormat; props != nil {
+		d.Set("cidr", props.Cidr)
+
+		commissioned := props.CommissionedState == network.Commissioning || props.CommissionedState == network.Commissioned
+		d.Set("commissioning_enabled", commissioned)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceCustomIpPrefixUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		parameters := network.TagsObject{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := azuresdkhacks.UpdateCustomIPPrefixTags(ctx, client, id.ResourceGroup, id.Name, parameters); err != nil {
+			return fmt.Errorf("updating tags for %s: %+v", *id, err)
+		}
+	}
+
+	if d.HasChange("commissioning_enabled") {
+		if err := updateCustomIpPrefixCommissionedState(ctx, client, *id, d.Get("commissioning_enabled").(bool), d.Get("signed_message").(string), d.Get("authorization_message").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomIpPrefixRead(d, meta)
+}
+
+func resourceCustomIpPrefixDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := azuresdkhacks.GetCustomIPPrefix(ctx, client, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// a commissioned range is advertised by Azure and has to be withdrawn before it can be deprovisioned
+	if props := existing.CustomIPPrefixPropertiesFormat; props != nil {
+		if props.CommissionedState == network.Commissioning || props.CommissionedState == network.Commissioned {
+			if err := updateCustomIpPrefixCommissionedState(ctx, client, *id, false, d.Get("signed_message").(string), d.Get("authorization_message").(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	future, err := azuresdkhacks.DeleteCustomIPPrefix(ctx, client, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// updateCustomIpPrefixCommissionedState either commissions or decommissions the Custom IP Prefix and then
+// waits for the transition to complete, since the PUT returns before the range is (no longer) advertised - the
+// ownership validation messages are sent alongside, since otherwise these would be removed by the PUT
+func updateCustomIpPrefixCommissionedState(ctx context.Context, client *network.CustomIPPrefixesClient, id parse.CustomIpPrefixId, commission bool, signedMessage string, authorizationMessage string) error {
+	desiredState := network.Decommissioning
+	// a range which is still being commissioned can be decommissioned, e.g. when it's deleted part-way through
+	pendingStates := []string{string(network.Commissioning), string(network.Commissioned), string(network.Decommissioning)}
+	targetState := network.Provisioned
+	action := "decommissioning"
+	if commission {
+		desiredState = network.Commissioning
+		pendingStates = []string{string(network.Provisioned), string(network.Commissioning)}
+		targetState = network.Commissioned
+		action = "commissioning"
+	}
+
+	existing, err := azuresdkhacks.GetCustomIPPrefix(ctx, client, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.CustomIPPrefixPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	parameters := network.CustomIPPrefix{
+		Location: existing.Location,
+		CustomIPPrefixPropertiesFormat: &network.CustomIPPrefixPropertiesFormat{
+			Cidr:              existing.CustomIPPrefixPropertiesFormat.Cidr,
+			CommissionedState: desiredState,
+		},
+		Zones: existing.Zones,
+		Tags:  existing.Tags,
+	}
+
+	future, err := azuresdkhacks.CreateOrUpdateCustomIPPrefix(ctx, client, id.ResourceGroup, id.Name, parameters, signedMessage, authorizationMessage)
+	if err != nil {
+		return fmt.Errorf("%s %s: %+v", action, id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s of %s: %+v", action, id, err)
+	}
+
+	if err := waitForCustomIpPrefixCommissionedState(ctx, client, id, pendingStates, string(targetState)); err != nil {
+		return fmt.Errorf("waiting for %s of %s to complete: %+v", action, id, err)
+	}
+
+	return nil
+}
+
+func waitForCustomIpPrefixCommissionedState(ctx context.Context, client *network.CustomIPPrefixesClient, id parse.CustomIpPrefixId, pending []string, target string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:                   pending,
+		Target:                    []string{target},
+		Refresh:                   customIpPrefixCommissionedStateRefreshFunc(ctx, client, id),
+		MinTimeout:                1 * time.Minute,
+		ContinuousTargetOccurence: 2,
+		Timeout:                   time.Until(deadline),
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func customIpPrefixCommissionedStateRefreshFunc(ctx context.Context, client *network.CustomIPPrefixesClient, id parse.CustomIpPrefixId) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := azuresdkhacks.GetCustomIPPrefix(ctx, client, id.ResourceGroup, id.Name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if resp.CustomIPPrefixPropertiesFormat == nil {
+			return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+		}
+
+		return resp, string(resp.CustomIPPrefixPropertiesFormat.CommissionedState), nil
+	}
+}
@@ -0,0 +1,232 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/azuresdkhacks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type CustomIpPrefixResource struct {
+	cidr                 string
+	signedMessage        string
+	authorizationMessage string
+}
+
+func TestAccCustomIpPrefix(t *testing.T) {
+	// NOTE: this is a combined test rather than separate split out tests since these
+	// require an IP range which is owned by the test account and can only be provisioned once at a time
+	testCases := map[string]map[string]func(t *testing.T){
+		"prefix": {
+			"basic":          testAccCustomIpPrefix_basic,
+			"requiresImport": testAccCustomIpPrefix_requiresImport,
+			"commissioning":  testAccCustomIpPrefix_commissioning,
+			"publicIpPrefix": testAccCustomIpPrefix_publicIpPrefix,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func newCustomIpPrefixResource(t *testing.T) CustomIpPrefixResource {
+	r := CustomIpPrefixResource{
+		cidr:                 os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_CIDR"),
+		signedMessage:        os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_SIGNED_MESSAGE"),
+		authorizationMessage: os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_AUTHORIZATION_MESSAGE"),
+	}
+	if r.cidr == "" || r.signedMessage == "" || r.authorizationMessage == "" {
+		t.Skip("Skipping as `ARM_TEST_CUSTOM_IP_PREFIX_CIDR`, `ARM_TEST_CUSTOM_IP_PREFIX_SIGNED_MESSAGE` and `ARM_TEST_CUSTOM_IP_PREFIX_AUTHORIZATION_MESSAGE` must be set")
+	}
+	return r
+}
+
+func testAccCustomIpPrefix_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	r := newCustomIpPrefixResource(t)
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("commissioning_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("signed_message", "authorization_message"),
+	})
+}
+
+func testAccCustomIpPrefix_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	r := newCustomIpPrefixResource(t)
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccCustomIpPrefix_commissioning(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	r := newCustomIpPrefixResource(t)
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("signed_message", "authorization_message"),
+		{
+			Config: r.commissioned(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("commissioning_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("signed_message", "authorization_message"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("commissioning_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("signed_message", "authorization_message"),
+	})
+}
+
+func testAccCustomIpPrefix_publicIpPrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	r := newCustomIpPrefixResource(t)
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.publicIpPrefix(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_public_ip_prefix.test").Key("custom_ip_prefix_id").Exists(),
+			),
+		},
+		data.ImportStep("signed_message", "authorization_message"),
+	})
+}
+
+func (CustomIpPrefixResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.CustomIpPrefixID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := azuresdkhacks.GetCustomIPPrefix(ctx, client.Network.CustomIPPrefixesClient, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r CustomIpPrefixResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_custom_ip_prefix" "test" {
+  name                  = "acctest-cip-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  cidr                  = "%s"
+  signed_message        = "%s"
+  authorization_message = "%s"
+}
+`, r.template(data), data.RandomInteger, r.cidr, r.signedMessage, r.authorizationMessage)
+}
+
+func (r CustomIpPrefixResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_custom_ip_prefix" "import" {
+  name                  = azurerm_custom_ip_prefix.test.name
+  resource_group_name   = azurerm_custom_ip_prefix.test.resource_group_name
+  location              = azurerm_custom_ip_prefix.test.location
+  cidr                  = azurerm_custom_ip_prefix.test.cidr
+  signed_message        = azurerm_custom_ip_prefix.test.signed_message
+  authorization_message = azurerm_custom_ip_prefix.test.authorization_message
+}
+`, r.basic(data))
+}
+
+func (r CustomIpPrefixResource) commissioned(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_custom_ip_prefix" "test" {
+  name                  = "acctest-cip-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  cidr                  = "%s"
+  signed_message        = "%s"
+  authorization_message = "%s"
+  commissioning_enabled = true
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, r.cidr, r.signedMessage, r.authorizationMessage)
+}
+
+func (r CustomIpPrefixResource) publicIpPrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctest-pipp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  prefix_length       = 31
+  custom_ip_prefix_id = azurerm_custom_ip_prefix.test.id
+}
+`, r.basic(data), data.RandomInteger)
+}
+
+func (CustomIpPrefixResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cip-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
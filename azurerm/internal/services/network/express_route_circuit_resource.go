@@ -8,7 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -16,6 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
@@ -39,6 +41,9 @@ func resourceExpressRouteCircuit() *schema.Resource {
 			customdiff.ForceNewIfChange("bandwidth_in_mbps", func(old, new, meta interface{}) bool {
 				return new.(int) < old.(int)
 			}),
+			customdiff.ForceNewIfChange("bandwidth_in_gbps", func(old, new, meta interface{}) bool {
+				return new.(float64) < old.(float64)
+			}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -61,21 +66,46 @@ func resourceExpressRouteCircuit() *schema.Resource {
 
 			"service_provider_name": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
+				RequiredWith:     []string{"peering_location", "bandwidth_in_mbps"},
+				ConflictsWith:    []string{"express_route_port_id", "bandwidth_in_gbps"},
+				AtLeastOneOf:     []string{"service_provider_name", "express_route_port_id"},
 			},
 
 			"peering_location": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
+				RequiredWith:     []string{"service_provider_name", "bandwidth_in_mbps"},
+				ConflictsWith:    []string{"express_route_port_id", "bandwidth_in_gbps"},
 			},
 
 			"bandwidth_in_mbps": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				RequiredWith:  []string{"service_provider_name", "peering_location"},
+				ConflictsWith: []string{"express_route_port_id", "bandwidth_in_gbps"},
+			},
+
+			"express_route_port_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validate.ExpressRoutePortID,
+				RequiredWith:  []string{"bandwidth_in_gbps"},
+				ConflictsWith: []string{"service_provider_name", "peering_location", "bandwidth_in_mbps"},
+				AtLeastOneOf:  []string{"service_provider_name", "express_route_port_id"},
+			},
+
+			"bandwidth_in_gbps": {
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				ValidateFunc:  validate.ExpressRouteCircuitBandwidthInGbps,
+				RequiredWith:  []string{"express_route_port_id"},
+				ConflictsWith: []string{"service_provider_name", "peering_location", "bandwidth_in_mbps"},
 			},
 
 			"sku": {
@@ -158,9 +188,6 @@ func resourceExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interf
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	serviceProviderName := d.Get("service_provider_name").(string)
-	peeringLocation := d.Get("peering_location").(string)
-	bandwidthInMbps := int32(d.Get("bandwidth_in_mbps").(int))
 	sku := expandExpressRouteCircuitSku(d)
 	allowRdfeOps := d.Get("allow_classic_operations").(bool)
 	t := d.Get("tags").(map[string]interface{})
@@ -195,22 +222,28 @@ func resourceExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interf
 	erc.Sku = sku
 	erc.Tags = expandedTags
 
-	if erc.ExpressRouteCircuitPropertiesFormat != nil {
-		erc.ExpressRouteCircuitPropertiesFormat.AllowClassicOperations = &allowRdfeOps
-		if erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties != nil {
-			erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.ServiceProviderName = &serviceProviderName
-			erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.PeeringLocation = &peeringLocation
-			erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.BandwidthInMbps = &bandwidthInMbps
+	if erc.ExpressRouteCircuitPropertiesFormat == nil {
+		erc.ExpressRouteCircuitPropertiesFormat = &network.ExpressRouteCircuitPropertiesFormat{}
+	}
+	erc.ExpressRouteCircuitPropertiesFormat.AllowClassicOperations = &allowRdfeOps
+
+	// a circuit is either provisioned through a Service Provider, or directly on an ExpressRoute Port
+	if portId := d.Get("express_route_port_id").(string); portId != "" {
+		erc.ExpressRouteCircuitPropertiesFormat.ExpressRoutePort = &network.SubResource{
+			ID: utils.String(portId),
 		}
+		erc.ExpressRouteCircuitPropertiesFormat.BandwidthInGbps = utils.Float(d.Get("bandwidth_in_gbps").(float64))
 	} else {
-		erc.ExpressRouteCircuitPropertiesFormat = &network.ExpressRouteCircuitPropertiesFormat{
-			AllowClassicOperations: &allowRdfeOps,
-			ServiceProviderProperties: &network.ExpressRouteCircuitServiceProviderProperties{
-				ServiceProviderName: &serviceProviderName,
-				PeeringLocation:     &peeringLocation,
-				BandwidthInMbps:     &bandwidthInMbps,
-			},
+		serviceProviderName := d.Get("service_provider_name").(string)
+		peeringLocation := d.Get("peering_location").(string)
+		bandwidthInMbps := int32(d.Get("bandwidth_in_mbps").(int))
+
+		if erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties == nil {
+			erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties = &network.ExpressRouteCircuitServiceProviderProperties{}
 		}
+		erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.ServiceProviderName = &serviceProviderName
+		erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.PeeringLocation = &peeringLocation
+		erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.BandwidthInMbps = &bandwidthInMbps
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, erc)
@@ -293,6 +326,19 @@ func resourceExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("bandwidth_in_mbps", props.BandwidthInMbps)
 	}
 
+	if props := resp.ExpressRouteCircuitPropertiesFormat; props != nil {
+		expressRoutePortId := ""
+		if props.ExpressRoutePort != nil && props.ExpressRoutePort.ID != nil {
+			portId, err := parse.ExpressRoutePortID(*props.ExpressRoutePort.ID)
+			if err != nil {
+				return err
+			}
+			expressRoutePortId = portId.ID()
+		}
+		d.Set("express_route_port_id", expressRoutePortId)
+		d.Set("bandwidth_in_gbps", props.BandwidthInGbps)
+	}
+
 	d.Set("service_provider_provisioning_state", string(resp.ServiceProviderProvisioningState))
 	d.Set("service_key", resp.ServiceKey)
 	d.Set("allow_classic_operations", resp.AllowClassicOperations)
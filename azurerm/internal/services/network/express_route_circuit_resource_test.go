@@ -33,6 +33,7 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 			"requiresImport":               testAccExpressRouteCircuit_requiresImport,
 			"data_basic":                   testAccDataSourceExpressRoute_basicMetered,
 			"bandwidthReduction":           testAccExpressRouteCircuit_bandwidthReduction,
+			"port":                         testAccExpressRouteCircuit_port,
 		},
 		"PrivatePeering": {
 			"azurePrivatePeering":           testAccExpressRouteCircuitPeering_azurePrivatePeering,
@@ -52,6 +53,12 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 			"multiple":       testAccExpressRouteCircuitAuthorization_multiple,
 			"requiresImport": testAccExpressRouteCircuitAuthorization_requiresImport,
 		},
+		"CircuitConnection": {
+			"basic":          testAccExpressRouteCircuitConnection_basic,
+			"requiresImport": testAccExpressRouteCircuitConnection_requiresImport,
+			"complete":       testAccExpressRouteCircuitConnection_complete,
+			"update":         testAccExpressRouteCircuitConnection_update,
+		},
 	}
 
 	for group, m := range testCases {
@@ -259,6 +266,30 @@ func testAccExpressRouteCircuit_bandwidthReduction(t *testing.T) {
 	})
 }
 
+func testAccExpressRouteCircuit_port(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_circuit", "test")
+	r := ExpressRouteCircuitResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.portConfig(data, "5"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("bandwidth_in_gbps").HasValue("5"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.portConfig(data, "10"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("bandwidth_in_gbps").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ExpressRouteCircuitResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -506,3 +537,38 @@ resource "azurerm_express_route_circuit" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, bandwidth)
 }
+
+func (ExpressRouteCircuitResource) portConfig(data acceptance.TestData, bandwidth string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctest-erp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  express_route_port_id = azurerm_express_route_port.test.id
+  bandwidth_in_gbps     = %s
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, bandwidth)
+}
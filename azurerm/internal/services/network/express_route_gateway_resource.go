@@ -5,18 +5,21 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var expressRouteGatewayResourceName = "azurerm_express_route_gateway"
+
 func resourceExpressRouteGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceExpressRouteGatewayCreateUpdate,
@@ -74,6 +77,9 @@ func resourceExpressRouteGatewayCreateUpdate(d *schema.ResourceData, meta interf
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
+	locks.ByName(name, expressRouteGatewayResourceName)
+	defer locks.UnlockByName(name, expressRouteGatewayResourceName)
+
 	if d.IsNewResource() {
 		resp, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -183,6 +189,9 @@ func resourceExpressRouteGatewayDelete(d *schema.ResourceData, meta interface{})
 	resourceGroup := id.ResourceGroup
 	name := id.Path["expressRouteGateways"]
 
+	locks.ByName(name, expressRouteGatewayResourceName)
+	defer locks.UnlockByName(name, expressRouteGatewayResourceName)
+
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if response.WasNotFound(future.Response()) {
@@ -0,0 +1,549 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	msiParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
+	msiValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceExpressRoutePort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceExpressRoutePortCreate,
+		Read:   resourceExpressRoutePortRead,
+		Update: resourceExpressRoutePortUpdate,
+		Delete: resourceExpressRoutePortDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ExpressRoutePortID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"peering_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"bandwidth_in_gbps": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"encapsulation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Dot1Q),
+					string(network.QinQ),
+				}, false),
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.ResourceIdentityTypeUserAssigned),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ResourceIdentityTypeUserAssigned),
+							}, false),
+						},
+
+						"identity_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: msiValidate.UserAssignedIdentityID,
+							},
+						},
+					},
+				},
+			},
+
+			"link1": schemaExpressRoutePortLink(),
+
+			"link2": schemaExpressRoutePortLink(),
+
+			"guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mtu": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ethertype": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func schemaExpressRoutePortLink() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"admin_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"macsec_cipher": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  string(network.GcmAes128),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.GcmAes128),
+						string(network.GcmAes256),
+						string(network.GcmAesXpn128),
+						string(network.GcmAesXpn256),
+					}, false),
+				},
+
+				"macsec_ckn_keyvault_secret_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+				},
+
+				"macsec_cak_keyvault_secret_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+				},
+
+				"macsec_sci_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"router_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"interface_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"patch_panel_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"rack_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"connector_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceExpressRoutePortCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewExpressRoutePortID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_express_route_port", id.ID())
+	}
+
+	param := network.ExpressRoutePort{
+		Name:     utils.String(id.Name),
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		ExpressRoutePortPropertiesFormat: &network.ExpressRoutePortPropertiesFormat{
+			PeeringLocation: utils.String(d.Get("peering_location").(string)),
+			BandwidthInGbps: utils.Int32(int32(d.Get("bandwidth_in_gbps").(int))),
+			Encapsulation:   network.ExpressRoutePortsEncapsulation(d.Get("encapsulation").(string)),
+		},
+		Identity: expandExpressRoutePortIdentity(d.Get("identity").([]interface{})),
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	// the link properties can't be specified when the Port is first created, since the links only exist once
+	// the Port has been provisioned - so these are applied in a subsequent update below
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, param)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	_, hasLink1 := d.GetOk("link1")
+	_, hasLink2 := d.GetOk("link2")
+	if hasLink1 || hasLink2 {
+		param.ExpressRoutePortPropertiesFormat.Links = &[]network.ExpressRouteLink{
+			expandExpressRoutePortLink(1, d.Get("link1").([]interface{})),
+			expandExpressRoutePortLink(2, d.Get("link2").([]interface{})),
+		}
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, param)
+		if err != nil {
+			return fmt.Errorf("updating the links for %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the update of the links for %s: %+v", id, err)
+		}
+	}
+
+	return resourceExpressRoutePortRead(d, meta)
+}
+
+func resourceExpressRoutePortRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ExpressRoutePortID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	identity, err := flattenExpressRoutePortIdentity(resp.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	if props := resp.ExpressRoutePortPropertiesFormat; props != nil {
+		d.Set("peering_location", props.PeeringLocation)
+		d.Set("bandwidth_in_gbps", props.BandwidthInGbps)
+		d.Set("encapsulation", string(props.Encapsulation))
+		d.Set("guid", props.ResourceGUID)
+		d.Set("mtu", props.Mtu)
+		d.Set("ethertype", props.EtherType)
+
+		link1, link2, err := flattenExpressRoutePortLinks(props.Links)
+		if err != nil {
+			return fmt.Errorf("flattening links: %+v", err)
+		}
+		if err := d.Set("link1", link1); err != nil {
+			return fmt.Errorf("setting `link1`: %+v", err)
+		}
+		if err := d.Set("link2", link2); err != nil {
+			return fmt.Errorf("setting `link2`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceExpressRoutePortUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ExpressRoutePortID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	param := network.ExpressRoutePort{
+		Name:     utils.String(id.Name),
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		ExpressRoutePortPropertiesFormat: &network.ExpressRoutePortPropertiesFormat{
+			PeeringLocation: utils.String(d.Get("peering_location").(string)),
+			BandwidthInGbps: utils.Int32(int32(d.Get("bandwidth_in_gbps").(int))),
+			Encapsulation:   network.ExpressRoutePortsEncapsulation(d.Get("encapsulation").(string)),
+			Links: &[]network.ExpressRouteLink{
+				expandExpressRoutePortLink(1, d.Get("link1").([]interface{})),
+				expandExpressRoutePortLink(2, d.Get("link2").([]interface{})),
+			},
+		},
+		Identity: expandExpressRoutePortIdentity(d.Get("identity").([]interface{})),
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, param)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceExpressRoutePortRead(d, meta)
+}
+
+func resourceExpressRoutePortDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ExpressRoutePortID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandExpressRoutePortIdentity(input []interface{}) *network.ManagedServiceIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	identityIds := make(map[string]*network.ManagedServiceIdentityUserAssignedIdentitiesValue)
+	for _, id := range v["identity_ids"].([]interface{}) {
+		identityIds[id.(string)] = &network.ManagedServiceIdentityUserAssignedIdentitiesValue{}
+	}
+
+	return &network.ManagedServiceIdentity{
+		Type:                   network.ResourceIdentityType(v["type"].(string)),
+		UserAssignedIdentities: identityIds,
+	}
+}
+
+func flattenExpressRoutePortIdentity(input *network.ManagedServiceIdentity) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	// the API returns the identity type in camelCase
+	identityType := string(input.Type)
+	if strings.EqualFold(identityType, string(network.ResourceIdentityTypeUserAssigned)) {
+		identityType = string(network.ResourceIdentityTypeUserAssigned)
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		id, err := msiParse.UserAssignedIdentityID(key)
+		if err != nil {
+			return nil, err
+		}
+		identityIds = append(identityIds, id.ID())
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         identityType,
+			"identity_ids": identityIds,
+		},
+	}, nil
+}
+
+// expandExpressRoutePortLink builds the properties of the link with the specified index - the names of the links
+// within an ExpressRoute Port are fixed as `link1` and `link2`
+func expandExpressRoutePortLink(index int, input []interface{}) network.ExpressRouteLink {
+	link := network.ExpressRouteLink{
+		Name: utils.String(fmt.Sprintf("link%d", index)),
+		ExpressRouteLinkPropertiesFormat: &network.ExpressRouteLinkPropertiesFormat{
+			AdminState:   network.ExpressRouteLinkAdminStateDisabled,
+			MacSecConfig: &network.ExpressRouteLinkMacSecConfig{},
+		},
+	}
+	if len(input) == 0 || input[0] == nil {
+		return link
+	}
+	v := input[0].(map[string]interface{})
+
+	if v["admin_enabled"].(bool) {
+		link.ExpressRouteLinkPropertiesFormat.AdminState = network.ExpressRouteLinkAdminStateEnabled
+	}
+
+	sciState := network.ExpressRouteLinkMacSecSciStateDisabled
+	if v["macsec_sci_enabled"].(bool) {
+		sciState = network.ExpressRouteLinkMacSecSciStateEnabled
+	}
+
+	macSecConfig := &network.ExpressRouteLinkMacSecConfig{
+		Cipher:   network.ExpressRouteLinkMacSecCipher(v["macsec_cipher"].(string)),
+		SciState: sciState,
+	}
+	if cknId := v["macsec_ckn_keyvault_secret_id"].(string); cknId != "" {
+		macSecConfig.CknSecretIdentifier = utils.String(cknId)
+	}
+	if cakId := v["macsec_cak_keyvault_secret_id"].(string); cakId != "" {
+		macSecConfig.CakSecretIdentifier = utils.String(cakId)
+	}
+	link.ExpressRouteLinkPropertiesFormat.MacSecConfig = macSecConfig
+
+	return link
+}
+
+func flattenExpressRoutePortLinks(input *[]network.ExpressRouteLink) ([]interface{}, []interface{}, error) {
+	link1 := make([]interface{}, 0)
+	link2 := make([]interface{}, 0)
+	if input == nil {
+		return link1, link2, nil
+	}
+
+	for _, item := range *input {
+		if item.Name == nil {
+			continue
+		}
+
+		output := flattenExpressRoutePortLink(item)
+		switch *item.Name {
+		case "link1":
+			link1 = output
+		case "link2":
+			link2 = output
+		default:
+			return nil, nil, fmt.Errorf("unexpected link %q", *item.Name)
+		}
+	}
+
+	return link1, link2, nil
+}
+
+func flattenExpressRoutePortLink(input network.ExpressRouteLink) []interface{} {
+	id := ""
+	if input.ID != nil {
+		id = *input.ID
+	}
+
+	var routerName, interfaceName, patchPanelId, rackId, connectorType, cipher, cknId, cakId string
+	var adminEnabled, sciEnabled bool
+	if props := input.ExpressRouteLinkPropertiesFormat; props != nil {
+		if props.RouterName != nil {
+			routerName = *props.RouterName
+		}
+		if props.InterfaceName != nil {
+			interfaceName = *props.InterfaceName
+		}
+		if props.PatchPanelID != nil {
+			patchPanelId = *props.PatchPanelID
+		}
+		if props.RackID != nil {
+			rackId = *props.RackID
+		}
+		connectorType = string(props.ConnectorType)
+		adminEnabled = props.AdminState == network.ExpressRouteLinkAdminStateEnabled
+
+		if config := props.MacSecConfig; config != nil {
+			cipher = string(config.Cipher)
+			sciEnabled = config.SciState == network.ExpressRouteLinkMacSecSciStateEnabled
+			if config.CknSecretIdentifier != nil {
+				cknId = *config.CknSecretIdentifier
+			}
+			if config.CakSecretIdentifier != nil {
+				cakId = *config.CakSecretIdentifier
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":                            id,
+			"router_name":                   routerName,
+			"interface_name":                interfaceName,
+			"patch_panel_id":                patchPanelId,
+			"rack_id":                       rackId,
+			"connector_type":                connectorType,
+			"admin_enabled":                 adminEnabled,
+			"macsec_cipher":                 cipher,
+			"macsec_ckn_keyvault_secret_id": cknId,
+			"macsec_cak_keyvault_secret_id": cakId,
+			"macsec_sci_enabled":            sciEnabled,
+		},
+	}
+}
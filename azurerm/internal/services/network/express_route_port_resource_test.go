@@ -0,0 +1,281 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ExpressRoutePortResource struct{}
+
+func TestAccExpressRoutePort(t *testing.T) {
+	// NOTE: this is a combined test rather than separate split out tests due to
+	// the limited number of ExpressRoute Direct ports available within a peering location
+	testCases := map[string]map[string]func(t *testing.T){
+		"port": {
+			"basic":          testAccExpressRoutePort_basic,
+			"requiresImport": testAccExpressRoutePort_requiresImport,
+			"update":         testAccExpressRoutePort_update,
+			"macsec":         testAccExpressRoutePort_macsec,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccExpressRoutePort_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link1.0.id").Exists(),
+				check.That(data.ResourceName).Key("link2.0.id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccExpressRoutePort_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccExpressRoutePort_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.adminState(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link1.0.admin_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("link2.0.admin_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccExpressRoutePort_macsec(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.macsec(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link1.0.macsec_cipher").HasValue("GcmAes256"),
+				check.That(data.ResourceName).Key("link2.0.macsec_cipher").HasValue("GcmAes128"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ExpressRoutePortResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ExpressRoutePortID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.ExpressRoutePortsClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (ExpressRoutePortResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-erp-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ExpressRoutePortResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctestERP-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ExpressRoutePortResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port" "import" {
+  name                = azurerm_express_route_port.test.name
+  resource_group_name = azurerm_express_route_port.test.resource_group_name
+  location            = azurerm_express_route_port.test.location
+  peering_location    = azurerm_express_route_port.test.peering_location
+  bandwidth_in_gbps   = azurerm_express_route_port.test.bandwidth_in_gbps
+  encapsulation       = azurerm_express_route_port.test.encapsulation
+}
+`, r.basic(data))
+}
+
+func (r ExpressRoutePortResource) adminState(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctestERP-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+
+  link1 {
+    admin_enabled = true
+  }
+
+  link2 {
+    admin_enabled = false
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ExpressRoutePortResource) macsec(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id          = data.azurerm_client_config.current.tenant_id
+    object_id          = data.azurerm_client_config.current.object_id
+    secret_permissions = ["delete", "get", "list", "purge", "set"]
+  }
+
+  access_policy {
+    tenant_id          = data.azurerm_client_config.current.tenant_id
+    object_id          = azurerm_user_assigned_identity.test.principal_id
+    secret_permissions = ["get"]
+  }
+}
+
+resource "azurerm_key_vault_secret" "ckn" {
+  name         = "ckn"
+  value        = "0ae071f6f01ff3cd1a7d8c15f8c6a4e0a2ff6fa29e5b6d6f2ba6f0f2b61a0e7d"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_key_vault_secret" "cak" {
+  name         = "cak"
+  value        = "b2f2bd1a4b31b2ba5b0b1fa07e3e9c12"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctestERP-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  link1 {
+    macsec_cipher                 = "GcmAes256"
+    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
+    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
+  }
+
+  link2 {
+    macsec_cipher                 = "GcmAes128"
+    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
+    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomString, data.RandomInteger)
+}
@@ -0,0 +1,261 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceNetworkDdosCustomPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkDdosCustomPolicyCreateUpdate,
+		Read:   resourceNetworkDdosCustomPolicyRead,
+		Update: resourceNetworkDdosCustomPolicyCreateUpdate,
+		Delete: resourceNetworkDdosCustomPolicyDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.DdosCustomPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"protocol_custom_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.DdosCustomPolicyProtocolSyn),
+								string(network.DdosCustomPolicyProtocolTCP),
+								string(network.DdosCustomPolicyProtocolUDP),
+							}, false),
+						},
+
+						"trigger_rate_override": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a number of packets per second"),
+						},
+
+						"source_rate_override": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a number of packets per second"),
+						},
+
+						"trigger_sensitivity_override": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.DdosCustomPolicyTriggerSensitivityOverrideDefault),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.DdosCustomPolicyTriggerSensitivityOverrideDefault),
+								string(network.DdosCustomPolicyTriggerSensitivityOverrideHigh),
+								string(network.DdosCustomPolicyTriggerSensitivityOverrideLow),
+								string(network.DdosCustomPolicyTriggerSensitivityOverrideRelaxed),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"public_ip_address_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceNetworkDdosCustomPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.DdosCustomPoliciesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDdosCustomPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_network_ddos_custom_policy", id.ID())
+		}
+	}
+
+	parameters := network.DdosCustomPolicy{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		DdosCustomPolicyPropertiesFormat: &network.DdosCustomPolicyPropertiesFormat{
+			ProtocolCustomSettings: expandNetworkDdosCustomPolicyProtocolCustomSettings(d.Get("protocol_custom_setting").([]interface{})),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkDdosCustomPolicyRead(d, meta)
+}
+
+func resourceNetworkDdosCustomPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.DdosCustomPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DdosCustomPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.DdosCustomPolicyPropertiesFormat; props != nil {
+		if err := d.Set("protocol_custom_setting", flattenNetworkDdosCustomPolicyProtocolCustomSettings(props.ProtocolCustomSettings)); err != nil {
+			return fmt.Errorf("setting `protocol_custom_setting`: %+v", err)
+		}
+
+		if err := d.Set("public_ip_address_ids", flattenSubResourcesToIDs(props.PublicIPAddresses)); err != nil {
+			return fmt.Errorf("setting `public_ip_address_ids`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceNetworkDdosCustomPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.DdosCustomPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DdosCustomPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkDdosCustomPolicyProtocolCustomSettings(input []interface{}) *[]network.ProtocolCustomSettingsFormat {
+	results := make([]network.ProtocolCustomSettingsFormat, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		result := network.ProtocolCustomSettingsFormat{
+			Protocol:                   network.DdosCustomPolicyProtocol(v["protocol"].(string)),
+			TriggerSensitivityOverride: network.DdosCustomPolicyTriggerSensitivityOverride(v["trigger_sensitivity_override"].(string)),
+		}
+
+		if triggerRate := v["trigger_rate_override"].(string); triggerRate != "" {
+			result.TriggerRateOverride = utils.String(triggerRate)
+		}
+
+		if sourceRate := v["source_rate_override"].(string); sourceRate != "" {
+			result.SourceRateOverride = utils.String(sourceRate)
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func flattenNetworkDdosCustomPolicyProtocolCustomSettings(input *[]network.ProtocolCustomSettingsFormat) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		triggerRate := ""
+		if item.TriggerRateOverride != nil {
+			triggerRate = *item.TriggerRateOverride
+		}
+
+		sourceRate := ""
+		if item.SourceRateOverride != nil {
+			sourceRate = *item.SourceRateOverride
+		}
+
+		results = append(results, map[string]interface{}{
+			"protocol":                     string(item.Protocol),
+			"trigger_rate_override":        triggerRate,
+			"source_rate_override":         sourceRate,
+			"trigger_sensitivity_override": string(item.TriggerSensitivityOverride),
+		})
+	}
+
+	return results
+}
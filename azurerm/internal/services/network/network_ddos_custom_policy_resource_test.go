@@ -0,0 +1,178 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type NetworkDdosCustomPolicyResource struct{}
+
+func TestAccNetworkDdosCustomPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_ddos_custom_policy", "test")
+	r := NetworkDdosCustomPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkDdosCustomPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_ddos_custom_policy", "test")
+	r := NetworkDdosCustomPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkDdosCustomPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_ddos_custom_policy", "test")
+	r := NetworkDdosCustomPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protocol_custom_setting.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkDdosCustomPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_ddos_custom_policy", "test")
+	r := NetworkDdosCustomPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (NetworkDdosCustomPolicyResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.DdosCustomPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.DdosCustomPoliciesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r NetworkDdosCustomPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_ddos_custom_policy" "test" {
+  name                = "acctest-ddoscp-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkDdosCustomPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_ddos_custom_policy" "import" {
+  name                = azurerm_network_ddos_custom_policy.test.name
+  location            = azurerm_network_ddos_custom_policy.test.location
+  resource_group_name = azurerm_network_ddos_custom_policy.test.resource_group_name
+}
+`, r.basic(data))
+}
+
+func (r NetworkDdosCustomPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_ddos_custom_policy" "test" {
+  name                = "acctest-ddoscp-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  protocol_custom_setting {
+    protocol                     = "Tcp"
+    trigger_rate_override        = "10000"
+    source_rate_override         = "1000"
+    trigger_sensitivity_override = "High"
+  }
+
+  protocol_custom_setting {
+    protocol                     = "Udp"
+    trigger_sensitivity_override = "Relaxed"
+  }
+
+  protocol_custom_setting {
+    protocol = "Syn"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (NetworkDdosCustomPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ddoscp-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
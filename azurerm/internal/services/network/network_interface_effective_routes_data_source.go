@@ -0,0 +1,146 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkInterfaceEffectiveRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkInterfaceEffectiveRoutesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"effective_route": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"disable_bgp_route_propagation": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceEffectiveRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	future, err := client.GetEffectiveRouteTable(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Effective Routes for %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for retrieval of Effective Routes for %s: %+v", *id, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving Effective Routes for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("network_interface_id", id.ID())
+
+	if err := d.Set("effective_route", flattenNetworkInterfaceEffectiveRoutes(result.Value)); err != nil {
+		return fmt.Errorf("setting `effective_route`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkInterfaceEffectiveRoutes(input *[]network.EffectiveRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		disableBgpRoutePropagation := false
+		if item.DisableBgpRoutePropagation != nil {
+			disableBgpRoutePropagation = *item.DisableBgpRoutePropagation
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                          name,
+			"source":                        string(item.Source),
+			"state":                         string(item.State),
+			"address_prefixes":              utils.FlattenStringSlice(item.AddressPrefix),
+			"next_hop_type":                 string(item.NextHopType),
+			"next_hop_ip_addresses":         utils.FlattenStringSlice(item.NextHopIPAddress),
+			"disable_bgp_route_propagation": disableBgpRoutePropagation,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,252 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkInterfaceEffectiveSecurityRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkInterfaceEffectiveSecurityRulesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"effective_network_security_group": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"associated_subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"associated_network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"security_rule": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"priority": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"direction": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"access": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"source_port_ranges": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"destination_port_ranges": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"source_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"destination_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"expanded_source_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"expanded_destination_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceEffectiveSecurityRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	future, err := client.ListEffectiveNetworkSecurityGroups(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing Effective Network Security Groups for %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for listing of Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("listing Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("network_interface_id", id.ID())
+
+	if err := d.Set("effective_network_security_group", flattenNetworkInterfaceEffectiveNetworkSecurityGroups(result.Value)); err != nil {
+		return fmt.Errorf("setting `effective_network_security_group`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkInterfaceEffectiveNetworkSecurityGroups(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		networkSecurityGroupId := ""
+		if item.NetworkSecurityGroup != nil && item.NetworkSecurityGroup.ID != nil {
+			networkSecurityGroupId = *item.NetworkSecurityGroup.ID
+		}
+
+		associatedSubnetId := ""
+		associatedNetworkInterfaceId := ""
+		if association := item.Association; association != nil {
+			if association.Subnet != nil && association.Subnet.ID != nil {
+				associatedSubnetId = *association.Subnet.ID
+			}
+			if association.NetworkInterface != nil && association.NetworkInterface.ID != nil {
+				associatedNetworkInterfaceId = *association.NetworkInterface.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"network_security_group_id":       networkSecurityGroupId,
+			"associated_subnet_id":            associatedSubnetId,
+			"associated_network_interface_id": associatedNetworkInterfaceId,
+			"security_rule":                   flattenNetworkInterfaceEffectiveSecurityRules(item.EffectiveSecurityRules),
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkInterfaceEffectiveSecurityRules(input *[]network.EffectiveNetworkSecurityRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		priority := 0
+		if item.Priority != nil {
+			priority = int(*item.Priority)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                                  name,
+			"priority":                              priority,
+			"direction":                             string(item.Direction),
+			"access":                                string(item.Access),
+			"protocol":                              string(item.Protocol),
+			"source_port_ranges":                    flattenNetworkInterfaceEffectiveSecurityRuleValues(item.SourcePortRange, item.SourcePortRanges),
+			"destination_port_ranges":               flattenNetworkInterfaceEffectiveSecurityRuleValues(item.DestinationPortRange, item.DestinationPortRanges),
+			"source_address_prefixes":               flattenNetworkInterfaceEffectiveSecurityRuleValues(item.SourceAddressPrefix, item.SourceAddressPrefixes),
+			"destination_address_prefixes":          flattenNetworkInterfaceEffectiveSecurityRuleValues(item.DestinationAddressPrefix, item.DestinationAddressPrefixes),
+			"expanded_source_address_prefixes":      utils.FlattenStringSlice(item.ExpandedSourceAddressPrefix),
+			"expanded_destination_address_prefixes": utils.FlattenStringSlice(item.ExpandedDestinationAddressPrefix),
+		})
+	}
+
+	return results
+}
+
+// the API returns either a single value or a list of values for ports and prefixes, so these are combined into a list
+func flattenNetworkInterfaceEffectiveSecurityRuleValues(single *string, multiple *[]string) []interface{} {
+	if multiple != nil && len(*multiple) > 0 {
+		return utils.FlattenStringSlice(multiple)
+	}
+
+	results := make([]interface{}, 0)
+	if single != nil && *single != "" {
+		results = append(results, *single)
+	}
+	return results
+}
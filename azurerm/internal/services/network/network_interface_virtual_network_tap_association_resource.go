@@ -0,0 +1,210 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceNetworkInterfaceVirtualNetworkTapAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkInterfaceVirtualNetworkTapAssociationCreate,
+		Read:   resourceNetworkInterfaceVirtualNetworkTapAssociationRead,
+		Delete: resourceNetworkInterfaceVirtualNetworkTapAssociationDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.NetworkInterfaceTapConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"virtual_network_tap_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualNetworkTapID,
+			},
+		},
+	}
+}
+
+func resourceNetworkInterfaceVirtualNetworkTapAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	interfacesClient := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	networkInterfaceId, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkInterfaceTapConfigurationID(networkInterfaceId.SubscriptionId, networkInterfaceId.ResourceGroup, networkInterfaceId.Name, d.Get("name").(string))
+
+	locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+	defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+
+	networkInterface, err := interfacesClient.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *networkInterfaceId, err)
+	}
+	if networkInterface.InterfacePropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *networkInterfaceId)
+	}
+
+	lockingDetails, err := determineResourcesToLockFromIPConfiguration(networkInterface.InterfacePropertiesFormat.IPConfigurations)
+	if err != nil {
+		return fmt.Errorf("determining locking details: %+v", err)
+	}
+
+	lockingDetails.lock()
+	defer lockingDetails.unlock()
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_network_interface_virtual_network_tap_association", id.ID())
+	}
+
+	parameters := network.InterfaceTapConfiguration{
+		Name: utils.String(id.TapConfigurationName),
+		InterfaceTapConfigurationPropertiesFormat: &network.InterfaceTapConfigurationPropertiesFormat{
+			VirtualNetworkTap: &network.VirtualNetworkTap{
+				ID: utils.String(d.Get("virtual_network_tap_id").(string)),
+			},
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkInterfaceVirtualNetworkTapAssociationRead(d, meta)
+}
+
+func resourceNetworkInterfaceVirtualNetworkTapAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceTapConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TapConfigurationName)
+	d.Set("network_interface_id", parse.NewNetworkInterfaceID(id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName).ID())
+
+	virtualNetworkTapId := ""
+	if props := resp.InterfaceTapConfigurationPropertiesFormat; props != nil {
+		if props.VirtualNetworkTap != nil && props.VirtualNetworkTap.ID != nil {
+			tapId, err := parse.VirtualNetworkTapID(*props.VirtualNetworkTap.ID)
+			if err != nil {
+				return err
+			}
+			virtualNetworkTapId = tapId.ID()
+		}
+	}
+	d.Set("virtual_network_tap_id", virtualNetworkTapId)
+
+	return nil
+}
+
+func resourceNetworkInterfaceVirtualNetworkTapAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	interfacesClient := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceTapConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+	defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+
+	networkInterface, err := interfacesClient.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(networkInterface.Response) {
+			// deleting the Network Interface also deletes the TAP Configuration
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Network Interface %q (Resource Group %q): %+v", id.NetworkInterfaceName, id.ResourceGroup, err)
+	}
+	if networkInterface.InterfacePropertiesFormat == nil {
+		return fmt.Errorf("retrieving Network Interface %q (Resource Group %q): `properties` was nil", id.NetworkInterfaceName, id.ResourceGroup)
+	}
+
+	lockingDetails, err := determineResourcesToLockFromIPConfiguration(networkInterface.InterfacePropertiesFormat.IPConfigurations)
+	if err != nil {
+		return fmt.Errorf("determining locking details: %+v", err)
+	}
+
+	lockingDetails.lock()
+	defer lockingDetails.unlock()
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type NetworkInterfaceVirtualNetworkTapAssociationResource struct{}
+
+func TestAccNetworkInterfaceVirtualNetworkTapAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_virtual_network_tap_association", "test")
+	r := NetworkInterfaceVirtualNetworkTapAssociationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkInterfaceVirtualNetworkTapAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_virtual_network_tap_association", "test")
+	r := NetworkInterfaceVirtualNetworkTapAssociationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkInterfaceVirtualNetworkTapAssociation_multipleInterfaces(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_virtual_network_tap_association", "test")
+	r := NetworkInterfaceVirtualNetworkTapAssociationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.multipleInterfaces(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_network_interface_virtual_network_tap_association.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.NetworkInterfaceTapConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.InterfaceTapConfigurationsClient.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r NetworkInterfaceVirtualNetworkTapAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_virtual_network_tap_association" "test" {
+  name                   = "acctest-tapconfig-%d"
+  network_interface_id   = azurerm_network_interface.test.id
+  virtual_network_tap_id = azurerm_virtual_network_tap.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkInterfaceVirtualNetworkTapAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_virtual_network_tap_association" "import" {
+  name                   = azurerm_network_interface_virtual_network_tap_association.test.name
+  network_interface_id   = azurerm_network_interface_virtual_network_tap_association.test.network_interface_id
+  virtual_network_tap_id = azurerm_network_interface_virtual_network_tap_association.test.virtual_network_tap_id
+}
+`, r.basic(data))
+}
+
+func (r NetworkInterfaceVirtualNetworkTapAssociationResource) multipleInterfaces(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "second" {
+  name                = "acctestnic-second-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_virtual_network_tap_association" "test" {
+  name                   = "acctest-tapconfig-%d"
+  network_interface_id   = azurerm_network_interface.test.id
+  virtual_network_tap_id = azurerm_virtual_network_tap.test.id
+}
+
+resource "azurerm_network_interface_virtual_network_tap_association" "second" {
+  name                   = "acctest-tapconfig-%d"
+  network_interface_id   = azurerm_network_interface.second.id
+  virtual_network_tap_id = azurerm_virtual_network_tap.test.id
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vtap-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_network_interface" "collector" {
+  name                = "acctestnic-collector-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_network_tap" "test" {
+  name                                              = "acctest-vtap-%d"
+  resource_group_name                               = azurerm_resource_group.test.name
+  location                                          = azurerm_resource_group.test.location
+  destination_network_interface_ip_configuration_id = "${azurerm_network_interface.collector.id}/ipConfigurations/internal"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
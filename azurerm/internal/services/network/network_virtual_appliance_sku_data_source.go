@@ -0,0 +1,125 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkVirtualApplianceSku() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkVirtualApplianceSkuRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"vendor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"available_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"available_scale_units": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scale_unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkVirtualApplianceSkuRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceSkusClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Network Virtual Appliance SKU %q was not found", name)
+		}
+		return fmt.Errorf("retrieving Network Virtual Appliance SKU %q: %+v", name, err)
+	}
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("retrieving Network Virtual Appliance SKU %q: `id` was nil", name)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+
+	if props := resp.VirtualApplianceSkuPropertiesFormat; props != nil {
+		d.Set("vendor", props.Vendor)
+
+		if err := d.Set("available_versions", utils.FlattenStringSlice(props.AvailableVersions)); err != nil {
+			return fmt.Errorf("setting `available_versions`: %+v", err)
+		}
+
+		if err := d.Set("available_scale_units", flattenNetworkVirtualApplianceSkuScaleUnits(props.AvailableScaleUnits)); err != nil {
+			return fmt.Errorf("setting `available_scale_units`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func flattenNetworkVirtualApplianceSkuScaleUnits(input *[]network.VirtualApplianceSkuInstances) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		scaleUnit := ""
+		if item.ScaleUnit != nil {
+			scaleUnit = *item.ScaleUnit
+		}
+
+		instanceCount := 0
+		if item.InstanceCount != nil {
+			instanceCount = int(*item.InstanceCount)
+		}
+
+		results = append(results, map[string]interface{}{
+			"scale_unit":     scaleUnit,
+			"instance_count": instanceCount,
+		})
+	}
+
+	return results
+}
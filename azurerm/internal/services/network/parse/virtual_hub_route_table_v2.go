@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type VirtualHubRouteTableV2Id struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VirtualHubName string
+	RouteTableName string
+}
+
+func NewVirtualHubRouteTableV2ID(subscriptionId, resourceGroup, virtualHubName, routeTableName string) VirtualHubRouteTableV2Id {
+	return VirtualHubRouteTableV2Id{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VirtualHubName: virtualHubName,
+		RouteTableName: routeTableName,
+	}
+}
+
+func (id VirtualHubRouteTableV2Id) String() string {
+	segments := []string{
+		fmt.Sprintf("Route Table Name %q", id.RouteTableName),
+		fmt.Sprintf("Virtual Hub Name %q", id.VirtualHubName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Virtual Hub Route Table V2", segmentsStr)
+}
+
+func (id VirtualHubRouteTableV2Id) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s/routeTables/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.RouteTableName)
+}
+
+// VirtualHubRouteTableV2ID parses a VirtualHubRouteTableV2 ID into an VirtualHubRouteTableV2Id struct
+func VirtualHubRouteTableV2ID(input string) (*VirtualHubRouteTableV2Id, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualHubRouteTableV2Id{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+		return nil, err
+	}
+	if resourceId.RouteTableName, err = id.PopSegment("routeTables"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
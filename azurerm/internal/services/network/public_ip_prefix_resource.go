@@ -5,11 +5,13 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -62,6 +64,13 @@ func resourcePublicIpPrefix() *schema.Resource {
 				ValidateFunc: validation.IntBetween(0, 31),
 			},
 
+			"custom_ip_prefix_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CustomIpPrefixID,
+			},
+
 			"ip_prefix": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -102,6 +111,12 @@ func resourcePublicIpPrefixCreateUpdate(d *schema.ResourceData, meta interface{}
 		Zones: zones,
 	}
 
+	if v := d.Get("custom_ip_prefix_id").(string); v != "" {
+		publicIpPrefix.PublicIPPrefixPropertiesFormat.CustomIPPrefix = &network.SubResource{
+			ID: utils.String(v),
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, publicIpPrefix)
 	if err != nil {
 		return fmt.Errorf("creating/Updating Public IP Prefix %q (Resource Group %q): %+v", name, resGroup, err)
@@ -160,6 +175,16 @@ func resourcePublicIpPrefixRead(d *schema.ResourceData, meta interface{}) error
 	if props := resp.PublicIPPrefixPropertiesFormat; props != nil {
 		d.Set("prefix_length", props.PrefixLength)
 		d.Set("ip_prefix", props.IPPrefix)
+
+		customIpPrefixId := ""
+		if props.CustomIPPrefix != nil && props.CustomIPPrefix.ID != nil {
+			prefixId, err := parse.CustomIpPrefixID(*props.CustomIPPrefix.ID)
+			if err != nil {
+				return err
+			}
+			customIpPrefixId = prefixId.ID()
+		}
+		d.Set("custom_ip_prefix_id", customIpPrefixId)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
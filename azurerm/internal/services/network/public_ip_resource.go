@@ -6,7 +6,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -120,6 +120,37 @@ func resourcePublicIp() *schema.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
+			"ddos_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ddos_custom_policy_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.DdosCustomPolicyID,
+						},
+
+						"protection_coverage": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.DdosSettingsProtectionCoverageBasic),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.DdosSettingsProtectionCoverageBasic),
+								string(network.DdosSettingsProtectionCoverageStandard),
+							}, false),
+						},
+
+						"protected_ip_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"zones": azure.SchemaSingleZone(),
 
 			"tags": tags.Schema(),
@@ -192,6 +223,16 @@ func resourcePublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 		publicIp.PublicIPAddressPropertiesFormat.PublicIPPrefix = &publicIpPrefix
 	}
 
+	if v, ok := d.GetOk("ddos_settings"); ok {
+		publicIp.PublicIPAddressPropertiesFormat.DdosSettings = expandPublicIpDdosSettings(v.([]interface{}))
+	} else if !d.IsNewResource() && d.HasChange("ddos_settings") {
+		// removing the block resets the DDoS Settings back to the default Basic protection
+		publicIp.PublicIPAddressPropertiesFormat.DdosSettings = &network.DdosSettings{
+			ProtectionCoverage: network.DdosSettingsProtectionCoverageBasic,
+			ProtectedIP:        utils.Bool(false),
+		}
+	}
+
 	dnl, dnlOk := d.GetOk("domain_name_label")
 	rfqdn, rfqdnOk := d.GetOk("reverse_fqdn")
 
@@ -269,6 +310,19 @@ func resourcePublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 		d.Set("ip_address", props.IPAddress)
 		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+
+		ddosSettings, err := flattenPublicIpDdosSettings(props.DdosSettings)
+		if err != nil {
+			return err
+		}
+		// the API returns the default Basic protection when `ddos_settings` isn't specified, which is only
+		// set into the state when the block has been specified, to avoid a diff when it's been omitted
+		if publicIpDdosSettingsAreDefault(props.DdosSettings) && len(d.Get("ddos_settings").([]interface{})) == 0 {
+			ddosSettings = make([]interface{}, 0)
+		}
+		if err := d.Set("ddos_settings", ddosSettings); err != nil {
+			return fmt.Errorf("setting `ddos_settings`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -295,3 +349,63 @@ func resourcePublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandPublicIpDdosSettings(input []interface{}) *network.DdosSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	result := network.DdosSettings{
+		ProtectionCoverage: network.DdosSettingsProtectionCoverage(v["protection_coverage"].(string)),
+		ProtectedIP:        utils.Bool(v["protected_ip_enabled"].(bool)),
+	}
+
+	if policyId := v["ddos_custom_policy_id"].(string); policyId != "" {
+		result.DdosCustomPolicy = &network.SubResource{
+			ID: utils.String(policyId),
+		}
+	}
+
+	return &result
+}
+
+func publicIpDdosSettingsAreDefault(input *network.DdosSettings) bool {
+	if input == nil {
+		return true
+	}
+
+	protectionCoverage := input.ProtectionCoverage == "" || input.ProtectionCoverage == network.DdosSettingsProtectionCoverageBasic
+	customPolicy := input.DdosCustomPolicy != nil && input.DdosCustomPolicy.ID != nil && *input.DdosCustomPolicy.ID != ""
+	protectedIp := input.ProtectedIP != nil && *input.ProtectedIP
+	return protectionCoverage && !customPolicy && !protectedIp
+}
+
+func flattenPublicIpDdosSettings(input *network.DdosSettings) ([]interface{}, error) {
+	if input == nil {
+		return make([]interface{}, 0), nil
+	}
+
+	policyId := ""
+	if input.DdosCustomPolicy != nil && input.DdosCustomPolicy.ID != nil {
+		id, err := parse.DdosCustomPolicyID(*input.DdosCustomPolicy.ID)
+		if err != nil {
+			return nil, err
+		}
+		policyId = id.ID()
+	}
+
+	protectedIp := false
+	if input.ProtectedIP != nil {
+		protectedIp = *input.ProtectedIP
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"ddos_custom_policy_id": policyId,
+			"protection_coverage":   string(input.ProtectionCoverage),
+			"protected_ip_enabled":  protectedIp,
+		},
+	}, nil
+}
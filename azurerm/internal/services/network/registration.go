@@ -21,31 +21,34 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azurerm_application_gateway":                       dataSourceApplicationGateway(),
-		"azurerm_application_security_group":                dataSourceApplicationSecurityGroup(),
-		"azurerm_express_route_circuit":                     dataSourceExpressRouteCircuit(),
-		"azurerm_ip_group":                                  dataSourceIpGroup(),
-		"azurerm_nat_gateway":                               dataSourceNatGateway(),
-		"azurerm_network_ddos_protection_plan":              dataSourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                         dataSourceNetworkInterface(),
-		"azurerm_network_security_group":                    dataSourceNetworkSecurityGroup(),
-		"azurerm_network_watcher":                           dataSourceNetworkWatcher(),
-		"azurerm_private_endpoint_connection":               dataSourcePrivateEndpointConnection(),
-		"azurerm_private_link_service":                      dataSourcePrivateLinkService(),
-		"azurerm_private_link_service_endpoint_connections": dataSourcePrivateLinkServiceEndpointConnections(),
-		"azurerm_public_ip":                                 dataSourcePublicIP(),
-		"azurerm_public_ips":                                dataSourcePublicIPs(),
-		"azurerm_public_ip_prefix":                          dataSourcePublicIpPrefix(),
-		"azurerm_route_filter":                              dataSourceRouteFilter(),
-		"azurerm_route_table":                               dataSourceRouteTable(),
-		"azurerm_network_service_tags":                      dataSourceNetworkServiceTags(),
-		"azurerm_subnet":                                    dataSourceSubnet(),
-		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
-		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
-		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
-		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
-		"azurerm_web_application_firewall_policy":           dataWebApplicationFirewallPolicy(),
-		"azurerm_virtual_wan":                               dataSourceVirtualWan(),
+		"azurerm_application_gateway":                        dataSourceApplicationGateway(),
+		"azurerm_application_security_group":                 dataSourceApplicationSecurityGroup(),
+		"azurerm_express_route_circuit":                      dataSourceExpressRouteCircuit(),
+		"azurerm_ip_group":                                   dataSourceIpGroup(),
+		"azurerm_nat_gateway":                                dataSourceNatGateway(),
+		"azurerm_network_ddos_protection_plan":               dataSourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_interface":                          dataSourceNetworkInterface(),
+		"azurerm_network_interface_effective_routes":         dataSourceNetworkInterfaceEffectiveRoutes(),
+		"azurerm_network_interface_effective_security_rules": dataSourceNetworkInterfaceEffectiveSecurityRules(),
+		"azurerm_network_security_group":                     dataSourceNetworkSecurityGroup(),
+		"azurerm_network_virtual_appliance_sku":              dataSourceNetworkVirtualApplianceSku(),
+		"azurerm_network_watcher":                            dataSourceNetworkWatcher(),
+		"azurerm_private_endpoint_connection":                dataSourcePrivateEndpointConnection(),
+		"azurerm_private_link_service":                       dataSourcePrivateLinkService(),
+		"azurerm_private_link_service_endpoint_connections":  dataSourcePrivateLinkServiceEndpointConnections(),
+		"azurerm_public_ip":                                  dataSourcePublicIP(),
+		"azurerm_public_ips":                                 dataSourcePublicIPs(),
+		"azurerm_public_ip_prefix":                           dataSourcePublicIpPrefix(),
+		"azurerm_route_filter":                               dataSourceRouteFilter(),
+		"azurerm_route_table":                                dataSourceRouteTable(),
+		"azurerm_network_service_tags":                       dataSourceNetworkServiceTags(),
+		"azurerm_subnet":                                     dataSourceSubnet(),
+		"azurerm_virtual_hub":                                dataSourceVirtualHub(),
+		"azurerm_virtual_network_gateway":                    dataSourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_gateway_connection":         dataSourceVirtualNetworkGatewayConnection(),
+		"azurerm_virtual_network":                            dataSourceVirtualNetwork(),
+		"azurerm_web_application_firewall_policy":            dataWebApplicationFirewallPolicy(),
+		"azurerm_virtual_wan":                                dataSourceVirtualWan(),
 	}
 }
 
@@ -55,14 +58,19 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_application_gateway":                 resourceApplicationGateway(),
 		"azurerm_application_security_group":          resourceApplicationSecurityGroup(),
 		"azurerm_bastion_host":                        resourceBastionHost(),
+		"azurerm_custom_ip_prefix":                    resourceCustomIpPrefix(),
 		"azurerm_express_route_circuit_authorization": resourceExpressRouteCircuitAuthorization(),
+		"azurerm_express_route_circuit_connection":    resourceExpressRouteCircuitConnection(),
 		"azurerm_express_route_circuit_peering":       resourceExpressRouteCircuitPeering(),
 		"azurerm_express_route_circuit":               resourceExpressRouteCircuit(),
+		"azurerm_express_route_connection":            resourceExpressRouteConnection(),
 		"azurerm_express_route_gateway":               resourceExpressRouteGateway(),
+		"azurerm_express_route_port":                  resourceExpressRoutePort(),
 		"azurerm_ip_group":                            resourceIpGroup(),
 		"azurerm_local_network_gateway":               resourceLocalNetworkGateway(),
 		"azurerm_nat_gateway":                         resourceNatGateway(),
 		"azurerm_network_connection_monitor":          resourceNetworkConnectionMonitor(),
+		"azurerm_network_ddos_custom_policy":          resourceNetworkDdosCustomPolicy(),
 		"azurerm_network_ddos_protection_plan":        resourceNetworkDDoSProtectionPlan(),
 		"azurerm_network_interface":                   resourceNetworkInterface(),
 		"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
@@ -70,6 +78,7 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_network_interface_backend_address_pool_association":                     resourceNetworkInterfaceBackendAddressPoolAssociation(),
 		"azurerm_network_interface_nat_rule_association":                                 resourceNetworkInterfaceNatRuleAssociation(),
 		"azurerm_network_interface_security_group_association":                           resourceNetworkInterfaceSecurityGroupAssociation(),
+		"azurerm_network_interface_virtual_network_tap_association":                      resourceNetworkInterfaceVirtualNetworkTapAssociation(),
 		"azurerm_network_packet_capture":                                                 resourceNetworkPacketCapture(),
 		"azurerm_network_profile":                                                        resourceNetworkProfile(),
 		"azurerm_packet_capture":                                                         resourcePacketCapture(),
@@ -96,11 +105,17 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_virtual_hub_bgp_connection":                                             resourceVirtualHubBgpConnection(),
 		"azurerm_virtual_hub_connection":                                                 resourceVirtualHubConnection(),
 		"azurerm_virtual_hub_ip":                                                         resourceVirtualHubIP(),
+		"azurerm_virtual_hub_network_virtual_appliance":                                  resourceVirtualHubNetworkVirtualAppliance(),
+		"azurerm_virtual_hub_network_virtual_appliance_site":                             resourceVirtualHubNetworkVirtualApplianceSite(),
 		"azurerm_virtual_hub_route_table":                                                resourceVirtualHubRouteTable(),
+		"azurerm_virtual_hub_route_table_v2":                                             resourceVirtualHubRouteTableV2(),
 		"azurerm_virtual_network_gateway_connection":                                     resourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                                                resourceVirtualNetworkGateway(),
 		"azurerm_virtual_network_peering":                                                resourceVirtualNetworkPeering(),
+		"azurerm_virtual_network_tap":                                                    resourceVirtualNetworkTap(),
 		"azurerm_virtual_network":                                                        resourceVirtualNetwork(),
+		"azurerm_virtual_router":                                                         resourceVirtualRouter(),
+		"azurerm_virtual_router_peering":                                                 resourceVirtualRouterPeering(),
 		"azurerm_virtual_wan":                                                            resourceVirtualWan(),
 		"azurerm_vpn_gateway":                                                            resourceVPNGateway(),
 		"azurerm_vpn_gateway_connection":                                                 resourceVPNGatewayConnection(),
@@ -0,0 +1,25 @@
+package validate
+
+import (
+	"fmt"
+)
+
+// the bandwidths (in Gbps) of the Circuits which can be created on an ExpressRoute Port
+var expressRouteCircuitBandwidthsInGbps = []float64{1, 2, 5, 10, 40, 100}
+
+func ExpressRouteCircuitBandwidthInGbps(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(float64)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be float", k))
+		return warnings, errors
+	}
+
+	for _, bandwidth := range expressRouteCircuitBandwidthsInGbps {
+		if value == bandwidth {
+			return warnings, errors
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("expected %q to be one of %v, got %v", k, expressRouteCircuitBandwidthsInGbps, value))
+	return warnings, errors
+}
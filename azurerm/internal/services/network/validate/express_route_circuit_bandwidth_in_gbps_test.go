@@ -0,0 +1,46 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestExpressRouteCircuitBandwidthInGbps(t *testing.T) {
+	cases := []struct {
+		Input       float64
+		ExpectError bool
+	}{
+		{
+			Input:       0,
+			ExpectError: true,
+		},
+		{
+			Input:       0.5,
+			ExpectError: true,
+		},
+		{
+			Input:       1,
+			ExpectError: false,
+		},
+		{
+			Input:       10,
+			ExpectError: false,
+		},
+		{
+			Input:       20,
+			ExpectError: true,
+		},
+		{
+			Input:       100,
+			ExpectError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ExpressRouteCircuitBandwidthInGbps(tc.Input, "bandwidth_in_gbps")
+
+		hasError := len(errors) > 0
+		if tc.ExpectError != hasError {
+			t.Fatalf("Expected the ExpressRoute Circuit Bandwidth to trigger a validation error (%t) for '%v' but got %t", tc.ExpectError, tc.Input, hasError)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type VirtualHubNetworkVirtualApplianceResource struct{}
+
+func TestAccVirtualHubNetworkVirtualAppliance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance", "test")
+	r := VirtualHubNetworkVirtualApplianceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefix").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubNetworkVirtualAppliance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance", "test")
+	r := VirtualHubNetworkVirtualApplianceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualHubNetworkVirtualAppliance_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance", "test")
+	r := VirtualHubNetworkVirtualApplianceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scale_unit").HasValue("4"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VirtualHubNetworkVirtualApplianceResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.NetworkVirtualApplianceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.VirtualAppliancesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance" "test" {
+  name                = "acctest-nva-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_hub_id      = azurerm_virtual_hub.test.id
+  vendor              = "barracudasdwanrelease"
+  version             = "latest"
+  scale_unit          = "2"
+  asn                 = 64512
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance" "import" {
+  name                = azurerm_virtual_hub_network_virtual_appliance.test.name
+  resource_group_name = azurerm_virtual_hub_network_virtual_appliance.test.resource_group_name
+  location            = azurerm_virtual_hub_network_virtual_appliance.test.location
+  virtual_hub_id      = azurerm_virtual_hub_network_virtual_appliance.test.virtual_hub_id
+  vendor              = azurerm_virtual_hub_network_virtual_appliance.test.vendor
+  version             = azurerm_virtual_hub_network_virtual_appliance.test.version
+  scale_unit          = azurerm_virtual_hub_network_virtual_appliance.test.scale_unit
+  asn                 = azurerm_virtual_hub_network_virtual_appliance.test.asn
+}
+`, r.basic(data))
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance" "test" {
+  name                               = "acctest-nva-%d"
+  resource_group_name                = azurerm_resource_group.test.name
+  location                           = azurerm_resource_group.test.location
+  virtual_hub_id                     = azurerm_virtual_hub.test.id
+  vendor                             = "barracudasdwanrelease"
+  version                            = "latest"
+  scale_unit                         = "4"
+  asn                                = 64512
+  cloud_init_configuration_blob_urls = ["https://acctest%s.blob.core.windows.net/config/cloud-init.txt"]
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (VirtualHubNetworkVirtualApplianceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nva-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-vwan-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-vhub-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.1.0/24"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,235 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceVirtualHubNetworkVirtualApplianceSite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVirtualHubNetworkVirtualApplianceSiteCreateUpdate,
+		Read:   resourceVirtualHubNetworkVirtualApplianceSiteRead,
+		Update: resourceVirtualHubNetworkVirtualApplianceSiteCreateUpdate,
+		Delete: resourceVirtualHubNetworkVirtualApplianceSiteDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.NetworkVirtualApplianceSiteID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"network_virtual_appliance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkVirtualApplianceID,
+			},
+
+			"address_prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+
+			"o365_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_endpoint_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"default_endpoint_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"optimize_endpoint_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualHubNetworkVirtualApplianceSiteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceSitesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applianceId, err := parse.NetworkVirtualApplianceID(d.Get("network_virtual_appliance_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkVirtualApplianceSiteID(applianceId.SubscriptionId, applianceId.ResourceGroup, applianceId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.NetworkVirtualApplianceName, id.VirtualApplianceSiteName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_virtual_hub_network_virtual_appliance_site", id.ID())
+		}
+	}
+
+	parameters := network.VirtualApplianceSite{
+		Name: utils.String(id.VirtualApplianceSiteName),
+		VirtualApplianceSiteProperties: &network.VirtualApplianceSiteProperties{
+			AddressPrefix: utils.String(d.Get("address_prefix").(string)),
+			O365Policy:    expandVirtualHubNetworkVirtualApplianceSiteO365Policy(d.Get("o365_policy").([]interface{})),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NetworkVirtualApplianceName, id.VirtualApplianceSiteName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualHubNetworkVirtualApplianceSiteRead(d, meta)
+}
+
+func resourceVirtualHubNetworkVirtualApplianceSiteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceSiteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NetworkVirtualApplianceName, id.VirtualApplianceSiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.VirtualApplianceSiteName)
+	d.Set("network_virtual_appliance_id", parse.NewNetworkVirtualApplianceID(id.SubscriptionId, id.ResourceGroup, id.NetworkVirtualApplianceName).ID())
+
+	if props := resp.VirtualApplianceSiteProperties; props != nil {
+		d.Set("address_prefix", props.AddressPrefix)
+
+		if err := d.Set("o365_policy", flattenVirtualHubNetworkVirtualApplianceSiteO365Policy(props.O365Policy)); err != nil {
+			return fmt.Errorf("setting `o365_policy`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualHubNetworkVirtualApplianceSiteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceSiteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.NetworkVirtualApplianceName, id.VirtualApplianceSiteName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVirtualHubNetworkVirtualApplianceSiteO365Policy(input []interface{}) *network.Office365PolicyProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.Office365PolicyProperties{
+		BreakOutCategories: &network.BreakOutCategoryPolicies{
+			Allow:    utils.Bool(v["allow_endpoint_enabled"].(bool)),
+			Default:  utils.Bool(v["default_endpoint_enabled"].(bool)),
+			Optimize: utils.Bool(v["optimize_endpoint_enabled"].(bool)),
+		},
+	}
+}
+
+func flattenVirtualHubNetworkVirtualApplianceSiteO365Policy(input *network.Office365PolicyProperties) []interface{} {
+	if input == nil || input.BreakOutCategories == nil {
+		return make([]interface{}, 0)
+	}
+
+	allow := false
+	if input.BreakOutCategories.Allow != nil {
+		allow = *input.BreakOutCategories.Allow
+	}
+
+	defaultEndpoint := false
+	if input.BreakOutCategories.Default != nil {
+		defaultEndpoint = *input.BreakOutCategories.Default
+	}
+
+	optimize := false
+	if input.BreakOutCategories.Optimize != nil {
+		optimize = *input.BreakOutCategories.Optimize
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allow_endpoint_enabled":    allow,
+			"default_endpoint_enabled":  defaultEndpoint,
+			"optimize_endpoint_enabled": optimize,
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type VirtualHubNetworkVirtualApplianceSiteResource struct{}
+
+func TestAccVirtualHubNetworkVirtualApplianceSite_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance_site", "test")
+	r := VirtualHubNetworkVirtualApplianceSiteResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubNetworkVirtualApplianceSite_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance_site", "test")
+	r := VirtualHubNetworkVirtualApplianceSiteResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualHubNetworkVirtualApplianceSite_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_network_virtual_appliance_site", "test")
+	r := VirtualHubNetworkVirtualApplianceSiteResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("o365_policy.0.optimize_endpoint_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VirtualHubNetworkVirtualApplianceSiteResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.NetworkVirtualApplianceSiteID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.VirtualApplianceSitesClient.Get(ctx, id.ResourceGroup, id.NetworkVirtualApplianceName, id.VirtualApplianceSiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (VirtualHubNetworkVirtualApplianceSiteResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance_site" "test" {
+  name                         = "acctest-nvasite-%d"
+  network_virtual_appliance_id = azurerm_virtual_hub_network_virtual_appliance.test.id
+  address_prefix               = "192.168.1.0/24"
+}
+`, VirtualHubNetworkVirtualApplianceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r VirtualHubNetworkVirtualApplianceSiteResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance_site" "import" {
+  name                         = azurerm_virtual_hub_network_virtual_appliance_site.test.name
+  network_virtual_appliance_id = azurerm_virtual_hub_network_virtual_appliance_site.test.network_virtual_appliance_id
+  address_prefix               = azurerm_virtual_hub_network_virtual_appliance_site.test.address_prefix
+}
+`, r.basic(data))
+}
+
+func (VirtualHubNetworkVirtualApplianceSiteResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_network_virtual_appliance_site" "test" {
+  name                         = "acctest-nvasite-%d"
+  network_virtual_appliance_id = azurerm_virtual_hub_network_virtual_appliance.test.id
+  address_prefix               = "192.168.2.0/24"
+
+  o365_policy {
+    allow_endpoint_enabled    = true
+    default_endpoint_enabled  = false
+    optimize_endpoint_enabled = true
+  }
+}
+`, VirtualHubNetworkVirtualApplianceResource{}.basic(data), data.RandomInteger)
+}
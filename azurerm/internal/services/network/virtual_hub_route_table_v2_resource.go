@@ -0,0 +1,266 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceVirtualHubRouteTableV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVirtualHubRouteTableV2CreateUpdate,
+		Read:   resourceVirtualHubRouteTableV2Read,
+		Update: resourceVirtualHubRouteTableV2CreateUpdate,
+		Delete: resourceVirtualHubRouteTableV2Delete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.VirtualHubRouteTableV2ID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualHubID,
+			},
+
+			"attached_connections": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"All_Branches",
+						"All_Vnets",
+					}, false),
+				},
+			},
+
+			"route": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destinations": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+
+						"destinations_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "CIDR",
+							ValidateFunc: validation.StringInSlice([]string{
+								"CIDR",
+							}, false),
+						},
+
+						"next_hops": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPv4Address,
+							},
+						},
+
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "IPAddress",
+							ValidateFunc: validation.StringInSlice([]string{
+								"IPAddress",
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualHubRouteTableV2CreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubRouteTableV2sClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualHubRouteTableV2ID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroup, virtualHubId.Name, d.Get("name").(string))
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.RouteTableName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_virtual_hub_route_table_v2", id.ID())
+		}
+	}
+
+	parameters := network.VirtualHubRouteTableV2{
+		Name: utils.String(id.RouteTableName),
+		VirtualHubRouteTableV2Properties: &network.VirtualHubRouteTableV2Properties{
+			AttachedConnections: utils.ExpandStringSlice(d.Get("attached_connections").(*schema.Set).List()),
+			Routes:              expandVirtualHubRouteTableV2Routes(d.Get("route").([]interface{})),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, id.RouteTableName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualHubRouteTableV2Read(d, meta)
+}
+
+func resourceVirtualHubRouteTableV2Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubRouteTableV2sClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualHubRouteTableV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.RouteTableName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RouteTableName)
+	d.Set("virtual_hub_id", parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
+
+	if props := resp.VirtualHubRouteTableV2Properties; props != nil {
+		if err := d.Set("attached_connections", utils.FlattenStringSlice(props.AttachedConnections)); err != nil {
+			return fmt.Errorf("setting `attached_connections`: %+v", err)
+		}
+
+		if err := d.Set("route", flattenVirtualHubRouteTableV2Routes(props.Routes)); err != nil {
+			return fmt.Errorf("setting `route`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualHubRouteTableV2Delete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubRouteTableV2sClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualHubRouteTableV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.RouteTableName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVirtualHubRouteTableV2Routes(input []interface{}) *[]network.VirtualHubRouteV2 {
+	results := make([]network.VirtualHubRouteV2, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, network.VirtualHubRouteV2{
+			DestinationType: utils.String(v["destinations_type"].(string)),
+			Destinations:    utils.ExpandStringSlice(v["destinations"].(*schema.Set).List()),
+			NextHopType:     utils.String(v["next_hop_type"].(string)),
+			NextHops:        utils.ExpandStringSlice(v["next_hops"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func flattenVirtualHubRouteTableV2Routes(input *[]network.VirtualHubRouteV2) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		destinationType := ""
+		if item.DestinationType != nil {
+			destinationType = *item.DestinationType
+		}
+
+		nextHopType := ""
+		if item.NextHopType != nil {
+			nextHopType = *item.NextHopType
+		}
+
+		results = append(results, map[string]interface{}{
+			"destinations":      utils.FlattenStringSlice(item.Destinations),
+			"destinations_type": destinationType,
+			"next_hops":         utils.FlattenStringSlice(item.NextHops),
+			"next_hop_type":     nextHopType,
+		})
+	}
+
+	return results
+}
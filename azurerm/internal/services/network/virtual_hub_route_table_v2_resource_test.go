@@ -0,0 +1,214 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type VirtualHubRouteTableV2Resource struct{}
+
+func TestAccVirtualHubRouteTableV2_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_route_table_v2", "test")
+	r := VirtualHubRouteTableV2Resource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubRouteTableV2_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_route_table_v2", "test")
+	r := VirtualHubRouteTableV2Resource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualHubRouteTableV2_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_route_table_v2", "test")
+	r := VirtualHubRouteTableV2Resource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("route.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubRouteTableV2_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_route_table_v2", "test")
+	r := VirtualHubRouteTableV2Resource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VirtualHubRouteTableV2Resource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.VirtualHubRouteTableV2ID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.VirtualHubRouteTableV2sClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.RouteTableName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r VirtualHubRouteTableV2Resource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_route_table_v2" "test" {
+  name                 = "acctest-routetable-%d"
+  virtual_hub_id       = azurerm_virtual_hub.test.id
+  attached_connections = ["All_Vnets"]
+
+  route {
+    destinations = ["0.0.0.0/0"]
+    next_hops    = [azurerm_firewall.test.virtual_hub.0.private_ip_address]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubRouteTableV2Resource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_route_table_v2" "import" {
+  name                 = azurerm_virtual_hub_route_table_v2.test.name
+  virtual_hub_id       = azurerm_virtual_hub_route_table_v2.test.virtual_hub_id
+  attached_connections = azurerm_virtual_hub_route_table_v2.test.attached_connections
+
+  route {
+    destinations = ["0.0.0.0/0"]
+    next_hops    = [azurerm_firewall.test.virtual_hub.0.private_ip_address]
+  }
+}
+`, r.basic(data))
+}
+
+func (r VirtualHubRouteTableV2Resource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_route_table_v2" "test" {
+  name                 = "acctest-routetable-%d"
+  virtual_hub_id       = azurerm_virtual_hub.test.id
+  attached_connections = ["All_Vnets", "All_Branches"]
+
+  route {
+    destinations      = ["0.0.0.0/0"]
+    destinations_type = "CIDR"
+    next_hops         = [azurerm_firewall.test.virtual_hub.0.private_ip_address]
+    next_hop_type     = "IPAddress"
+  }
+
+  route {
+    destinations = ["10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"]
+    next_hops    = [azurerm_firewall.test.virtual_hub.0.private_ip_address]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (VirtualHubRouteTableV2Resource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vhub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-vwan-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-vhub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.1.0/24"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall" "test" {
+  name                = "acctest-firewall-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "AZFW_Hub"
+  firewall_policy_id  = azurerm_firewall_policy.test.id
+  threat_intel_mode   = ""
+
+  virtual_hub {
+    virtual_hub_id  = azurerm_virtual_hub.test.id
+    public_ip_count = 1
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -7,7 +7,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -184,14 +184,14 @@ func resourceVirtualNetworkGatewayConnection() *schema.Resource {
 							Required:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.DHGroup1),
-								string(network.DHGroup14),
-								string(network.DHGroup2),
-								string(network.DHGroup2048),
-								string(network.DHGroup24),
-								string(network.ECP256),
-								string(network.ECP384),
-								string(network.None),
+								string(network.DhGroupDHGroup1),
+								string(network.DhGroupDHGroup14),
+								string(network.DhGroupDHGroup2),
+								string(network.DhGroupDHGroup2048),
+								string(network.DhGroupDHGroup24),
+								string(network.DhGroupECP256),
+								string(network.DhGroupECP384),
+								string(network.DhGroupNone),
 							}, true),
 						},
 
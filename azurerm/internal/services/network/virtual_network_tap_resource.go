@@ -0,0 +1,212 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	loadBalancerParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loadbalancer/parse"
+	loadBalancerValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loadbalancer/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceVirtualNetworkTap() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVirtualNetworkTapCreateUpdate,
+		Read:   resourceVirtualNetworkTapRead,
+		Update: resourceVirtualNetworkTapCreateUpdate,
+		Delete: resourceVirtualNetworkTapDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.VirtualNetworkTapID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"destination_network_interface_ip_configuration_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NetworkInterfaceIpConfigurationID,
+				ExactlyOneOf: []string{
+					"destination_network_interface_ip_configuration_id",
+					"destination_load_balancer_frontend_ip_configuration_id",
+				},
+			},
+
+			"destination_load_balancer_frontend_ip_configuration_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: loadBalancerValidate.LoadBalancerFrontendIpConfigurationID,
+				ExactlyOneOf: []string{
+					"destination_network_interface_ip_configuration_id",
+					"destination_load_balancer_frontend_ip_configuration_id",
+				},
+			},
+
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4789,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceVirtualNetworkTapCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualNetworkTapsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewVirtualNetworkTapID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_virtual_network_tap", id.ID())
+		}
+	}
+
+	parameters := network.VirtualNetworkTap{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		VirtualNetworkTapPropertiesFormat: &network.VirtualNetworkTapPropertiesFormat{
+			DestinationPort: utils.Int32(int32(d.Get("destination_port").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("destination_network_interface_ip_configuration_id"); ok {
+		parameters.VirtualNetworkTapPropertiesFormat.DestinationNetworkInterfaceIPConfiguration = &network.InterfaceIPConfiguration{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("destination_load_balancer_frontend_ip_configuration_id"); ok {
+		parameters.VirtualNetworkTapPropertiesFormat.DestinationLoadBalancerFrontEndIPConfiguration = &network.FrontendIPConfiguration{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualNetworkTapRead(d, meta)
+}
+
+func resourceVirtualNetworkTapRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualNetworkTapsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkTapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.VirtualNetworkTapPropertiesFormat; props != nil {
+		networkInterfaceIPConfigurationId := ""
+		if props.DestinationNetworkInterfaceIPConfiguration != nil && props.DestinationNetworkInterfaceIPConfiguration.ID != nil {
+			ipConfigurationId, err := parse.NetworkInterfaceIpConfigurationID(*props.DestinationNetworkInterfaceIPConfiguration.ID)
+			if err != nil {
+				return err
+			}
+			networkInterfaceIPConfigurationId = ipConfigurationId.ID()
+		}
+		d.Set("destination_network_interface_ip_configuration_id", networkInterfaceIPConfigurationId)
+
+		loadBalancerFrontendIPConfigurationId := ""
+		if props.DestinationLoadBalancerFrontEndIPConfiguration != nil && props.DestinationLoadBalancerFrontEndIPConfiguration.ID != nil {
+			frontendIPConfigurationId, err := loadBalancerParse.LoadBalancerFrontendIpConfigurationID(*props.DestinationLoadBalancerFrontEndIPConfiguration.ID)
+			if err != nil {
+				return err
+			}
+			loadBalancerFrontendIPConfigurationId = frontendIPConfigurationId.ID()
+		}
+		d.Set("destination_load_balancer_frontend_ip_configuration_id", loadBalancerFrontendIPConfigurationId)
+
+		d.Set("destination_port", props.DestinationPort)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceVirtualNetworkTapDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualNetworkTapsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkTapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type VirtualNetworkTapResource struct{}
+
+func TestAccVirtualNetworkTap_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_tap", "test")
+	r := VirtualNetworkTapResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("destination_port").HasValue("4789"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualNetworkTap_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_tap", "test")
+	r := VirtualNetworkTapResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualNetworkTap_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_tap", "test")
+	r := VirtualNetworkTapResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("destination_port").HasValue("4790"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualNetworkTap_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_tap", "test")
+	r := VirtualNetworkTapResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualNetworkTap_loadBalancer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_tap", "test")
+	r := VirtualNetworkTapResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.loadBalancer(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("destination_network_interface_ip_configuration_id").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VirtualNetworkTapResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.VirtualNetworkTapID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.VirtualNetworkTapsClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r VirtualNetworkTapResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_tap" "test" {
+  name                                              = "acctest-vtap-%d"
+  resource_group_name                               = azurerm_resource_group.test.name
+  location                                          = azurerm_resource_group.test.location
+  destination_network_interface_ip_configuration_id = "${azurerm_network_interface.collector.id}/ipConfigurations/internal"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualNetworkTapResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_tap" "import" {
+  name                                              = azurerm_virtual_network_tap.test.name
+  resource_group_name                               = azurerm_virtual_network_tap.test.resource_group_name
+  location                                          = azurerm_virtual_network_tap.test.location
+  destination_network_interface_ip_configuration_id = azurerm_virtual_network_tap.test.destination_network_interface_ip_configuration_id
+}
+`, r.basic(data))
+}
+
+func (r VirtualNetworkTapResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_tap" "test" {
+  name                                              = "acctest-vtap-%d"
+  resource_group_name                               = azurerm_resource_group.test.name
+  location                                          = azurerm_resource_group.test.location
+  destination_network_interface_ip_configuration_id = "${azurerm_network_interface.collector.id}/ipConfigurations/internal"
+  destination_port                                  = 4790
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualNetworkTapResource) loadBalancer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb" "test" {
+  name                = "acctest-lb-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_network_tap" "test" {
+  name                                                   = "acctest-vtap-%d"
+  resource_group_name                                    = azurerm_resource_group.test.name
+  location                                               = azurerm_resource_group.test.location
+  destination_load_balancer_frontend_ip_configuration_id = azurerm_lb.test.frontend_ip_configuration.0.id
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (VirtualNetworkTapResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vtap-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_network_interface" "collector" {
+  name                = "acctestnic-collector-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -5,7 +5,7 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -135,14 +135,14 @@ func resourceVPNServerConfiguration() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.DHGroup1),
-								string(network.DHGroup2),
-								string(network.DHGroup14),
-								string(network.DHGroup24),
-								string(network.DHGroup2048),
-								string(network.ECP256),
-								string(network.ECP384),
-								string(network.None),
+								string(network.DhGroupDHGroup1),
+								string(network.DhGroupDHGroup2),
+								string(network.DhGroupDHGroup14),
+								string(network.DhGroupDHGroup24),
+								string(network.DhGroupDHGroup2048),
+								string(network.DhGroupECP256),
+								string(network.DhGroupECP384),
+								string(network.DhGroupNone),
 							}, false),
 						},
 
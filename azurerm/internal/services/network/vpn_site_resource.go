@@ -7,7 +7,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/response"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -84,6 +85,24 @@ func TestAccResourceGroup_withTags(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_tagsDrift(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+
+	testResource := ResourceGroupResource{}
+	assert := check.That(data.ResourceName)
+	data.ResourceTest(t, testResource, data.DriftSteps(acceptance.DriftStepData{
+		Config:       testResource.withTagsConfig,
+		TestResource: testResource,
+		Mutate:       testResource.updateTags,
+		// the PATCH replaces all of the tags, so both the count and the values change
+		ExpectedChanges: []string{"tags"},
+		Checks: []resource.TestCheckFunc{
+			assert.Key("tags.%").HasValue("2"),
+			assert.Key("tags.environment").HasValue("Production"),
+		},
+	}))
+}
+
 func (t ResourceGroupResource) Destroy(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	resourceGroup := state.Attributes["name"]
 
@@ -112,6 +131,21 @@ func (t ResourceGroupResource) Exists(ctx context.Context, client *clients.Clien
 	return utils.Bool(resp.Properties != nil), nil
 }
 
+func (t ResourceGroupResource) updateTags(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error {
+	name := state.Attributes["name"]
+
+	parameters := resources.GroupPatchable{
+		Tags: map[string]*string{
+			"environment": utils.String("Drifted"),
+		},
+	}
+	if _, err := client.Resource.GroupsClient.Update(ctx, name, parameters); err != nil {
+		return fmt.Errorf("updating Tags for Resource Group %q: %+v", name, err)
+	}
+
+	return nil
+}
+
 func (t ResourceGroupResource) basicConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -28,6 +28,7 @@ type Client struct {
 	FileSystemsClient        *filesystems.Client
 	ADLSGen2PathsClient      *paths.Client
 	ManagementPoliciesClient *storage.ManagementPoliciesClient
+	ObjectReplicationClient  *storage.ObjectReplicationPoliciesClient
 	BlobServicesClient       *storage.BlobServicesClient
 	CloudEndpointsClient     *storagesync.CloudEndpointsClient
 	EncryptionScopesClient   *storage.EncryptionScopesClient
@@ -53,6 +54,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	managementPoliciesClient := storage.NewManagementPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&managementPoliciesClient.Client, options.ResourceManagerAuthorizer)
 
+	objectReplicationPolicyClient := storage.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&objectReplicationPolicyClient.Client, options.ResourceManagerAuthorizer)
+
 	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
 
@@ -75,6 +79,7 @@ func NewClient(options *common.ClientOptions) *Client {
 		FileSystemsClient:        &fileSystemsClient,
 		ADLSGen2PathsClient:      &adlsGen2PathsClient,
 		ManagementPoliciesClient: &managementPoliciesClient,
+		ObjectReplicationClient:  &objectReplicationPolicyClient,
 		BlobServicesClient:       &blobServicesClient,
 		CloudEndpointsClient:     &cloudEndpointsClient,
 		EncryptionScopesClient:   &encryptionScopesClient,
@@ -0,0 +1,55 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This is a special case ID for an Object Replication, which is made up of the matching Object Replication Policies
+// on both the Source and the Destination Storage Accounts
+
+type ObjectReplicationId struct {
+	Src ObjectReplicationPolicyId
+	Dst ObjectReplicationPolicyId
+}
+
+func NewObjectReplicationID(srcPolicyId, dstPolicyId ObjectReplicationPolicyId) ObjectReplicationId {
+	return ObjectReplicationId{
+		Src: srcPolicyId,
+		Dst: dstPolicyId,
+	}
+}
+
+func (id ObjectReplicationId) String() string {
+	return fmt.Sprintf("Object Replication: (Source %s / Destination %s)", id.Src.String(), id.Dst.String())
+}
+
+func (id ObjectReplicationId) ID() string {
+	return fmt.Sprintf("%s;%s", id.Src.ID(), id.Dst.ID())
+}
+
+func ObjectReplicationID(input string) (*ObjectReplicationId, error) {
+	idParts := strings.Split(input, ";")
+	if len(idParts) != 2 {
+		return nil, fmt.Errorf("could not parse Object Replication ID, expected two Object Replication Policy IDs joined by `;`")
+	}
+
+	srcId, err := ObjectReplicationPolicyID(idParts[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse Source portion of Object Replication ID: %+v", err)
+	}
+	dstId, err := ObjectReplicationPolicyID(idParts[1])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse Destination portion of Object Replication ID: %+v", err)
+	}
+
+	// the Policy ID is generated by the Destination Storage Account and then applied to the Source Storage Account
+	if srcId.Name != dstId.Name {
+		return nil, fmt.Errorf("the Source (%q) and Destination (%q) Object Replication Policy names must match", srcId.Name, dstId.Name)
+	}
+
+	return &ObjectReplicationId{
+		Src: *srcId,
+		Dst: *dstId,
+	}, nil
+}
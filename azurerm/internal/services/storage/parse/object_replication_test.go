@@ -0,0 +1,97 @@
+package parse
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ObjectReplicationId{}
+
+func TestObjectReplicationIDFormatter(t *testing.T) {
+	srcId := NewObjectReplicationPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "objectReplicationPolicy1")
+	dstId := NewObjectReplicationPolicyID("12345678-1234-9876-4563-123456789012", "resGroup2", "storageAccount2", "objectReplicationPolicy1")
+	actual := NewObjectReplicationID(srcId, dstId).ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/objectReplicationPolicies/objectReplicationPolicy1;/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup2/providers/Microsoft.Storage/storageAccounts/storageAccount2/objectReplicationPolicies/objectReplicationPolicy1"
+	if actual != expected {
+		t.Fatalf("Expected %q, got %q", expected, actual)
+	}
+}
+
+func TestObjectReplicationID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ObjectReplicationId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing destination portion
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/objectReplicationPolicies/objectReplicationPolicy1;",
+			Error: true,
+		},
+
+		{
+			// missing source portion
+			Input: ";/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup2/providers/Microsoft.Storage/storageAccounts/storageAccount2/objectReplicationPolicies/objectReplicationPolicy1",
+			Error: true,
+		},
+
+		{
+			// storage account ids rather than policy ids
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1;/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup2/providers/Microsoft.Storage/storageAccounts/storageAccount2",
+			Error: true,
+		},
+
+		{
+			// mismatched policy names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/objectReplicationPolicies/objectReplicationPolicy1;/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup2/providers/Microsoft.Storage/storageAccounts/storageAccount2/objectReplicationPolicies/objectReplicationPolicy2",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/objectReplicationPolicies/objectReplicationPolicy1;/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup2/providers/Microsoft.Storage/storageAccounts/storageAccount2/objectReplicationPolicies/objectReplicationPolicy1",
+			Expected: &ObjectReplicationId{
+				Src: ObjectReplicationPolicyId{
+					SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:      "resGroup1",
+					StorageAccountName: "storageAccount1",
+					Name:               "objectReplicationPolicy1",
+				},
+				Dst: ObjectReplicationPolicyId{
+					SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:      "resGroup2",
+					StorageAccountName: "storageAccount2",
+					Name:               "objectReplicationPolicy1",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ObjectReplicationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value, but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error, but did not get one")
+		}
+
+		if !reflect.DeepEqual(*actual, *v.Expected) {
+			t.Fatalf("expected %+v, got %+v", *v.Expected, *actual)
+		}
+	}
+}
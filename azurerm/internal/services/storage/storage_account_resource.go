@@ -282,6 +282,45 @@ func resourceStorageAccount() *schema.Resource {
 								},
 							},
 						},
+
+						"versioning_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"change_feed_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"change_feed_retention_in_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+
+						"last_access_time_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"restore_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -603,11 +642,68 @@ func resourceStorageAccount() *schema.Resource {
 				}
 			}
 
+			if err := validateStorageAccountBlobProperties(d.Get("blob_properties").([]interface{}), d.Get("is_hns_enabled").(bool)); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
 }
 
+func validateStorageAccountBlobProperties(input []interface{}, isHnsEnabled bool) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	changeFeedEnabled := v["change_feed_enabled"].(bool)
+	if v["change_feed_retention_in_days"].(int) != 0 && !changeFeedEnabled {
+		return fmt.Errorf("`blob_properties.0.change_feed_retention_in_days` can only be specified when `blob_properties.0.change_feed_enabled` is `true`")
+	}
+
+	restorePolicies := v["restore_policy"].([]interface{})
+
+	// Versioning, the Change Feed and Point-in-Time Restore aren't supported for accounts with a Hierarchical Namespace
+	if isHnsEnabled {
+		if v["versioning_enabled"].(bool) {
+			return fmt.Errorf("`blob_properties.0.versioning_enabled` can't be `true` when `is_hns_enabled` is `true`")
+		}
+
+		if changeFeedEnabled {
+			return fmt.Errorf("`blob_properties.0.change_feed_enabled` can't be `true` when `is_hns_enabled` is `true`")
+		}
+
+		if len(restorePolicies) > 0 && restorePolicies[0] != nil {
+			return fmt.Errorf("`blob_properties.0.restore_policy` can't be specified when `is_hns_enabled` is `true`")
+		}
+	}
+
+	if len(restorePolicies) == 0 || restorePolicies[0] == nil {
+		return nil
+	}
+	restoreDays := restorePolicies[0].(map[string]interface{})["days"].(int)
+
+	if !v["versioning_enabled"].(bool) {
+		return fmt.Errorf("`blob_properties.0.versioning_enabled` must be `true` when `blob_properties.0.restore_policy` is specified")
+	}
+
+	if !changeFeedEnabled {
+		return fmt.Errorf("`blob_properties.0.change_feed_enabled` must be `true` when `blob_properties.0.restore_policy` is specified")
+	}
+
+	deletePolicies := v["delete_retention_policy"].([]interface{})
+	if len(deletePolicies) == 0 || deletePolicies[0] == nil {
+		return fmt.Errorf("`blob_properties.0.delete_retention_policy` must be specified when `blob_properties.0.restore_policy` is specified")
+	}
+
+	if deleteDays := deletePolicies[0].(map[string]interface{})["days"].(int); deleteDays <= restoreDays {
+		return fmt.Errorf("`blob_properties.0.restore_policy.0.days` (%d) must be less than `blob_properties.0.delete_retention_policy.0.days` (%d)", restoreDays, deleteDays)
+	}
+
+	return nil
+}
+
 func validateAzureRMStorageAccountTags(v interface{}, _ string) (warnings []string, errors []error) {
 	tagsMap := v.(map[string]interface{})
 
@@ -1488,9 +1584,19 @@ func expandBlobProperties(input []interface{}) storage.BlobServiceProperties {
 			DeleteRetentionPolicy: &storage.DeleteRetentionPolicy{
 				Enabled: utils.Bool(false),
 			},
+			IsVersioningEnabled: utils.Bool(false),
+			ChangeFeed: &storage.ChangeFeed{
+				Enabled: utils.Bool(false),
+			},
+			LastAccessTimeTrackingPolicy: &storage.LastAccessTimeTrackingPolicy{
+				Enable: utils.Bool(false),
+			},
+			RestorePolicy: expandBlobPropertiesRestorePolicy(nil),
 		},
 	}
 
+	// when the `blob_properties` block is removed the features above are explicitly disabled, since
+	// these would otherwise be left enabled on the Blob Service
 	if len(input) == 0 || input[0] == nil {
 		return props
 	}
@@ -1504,9 +1610,41 @@ func expandBlobProperties(input []interface{}) storage.BlobServiceProperties {
 	corsRaw := v["cors_rule"].([]interface{})
 	props.BlobServicePropertiesProperties.Cors = expandBlobPropertiesCors(corsRaw)
 
+	props.BlobServicePropertiesProperties.IsVersioningEnabled = utils.Bool(v["versioning_enabled"].(bool))
+
+	props.BlobServicePropertiesProperties.ChangeFeed = &storage.ChangeFeed{
+		Enabled: utils.Bool(v["change_feed_enabled"].(bool)),
+	}
+	if days := v["change_feed_retention_in_days"].(int); days != 0 {
+		props.BlobServicePropertiesProperties.ChangeFeed.RetentionInDays = utils.Int32(int32(days))
+	}
+
+	props.BlobServicePropertiesProperties.LastAccessTimeTrackingPolicy = &storage.LastAccessTimeTrackingPolicy{
+		Enable: utils.Bool(v["last_access_time_enabled"].(bool)),
+	}
+
+	restorePolicyRaw := v["restore_policy"].([]interface{})
+	props.BlobServicePropertiesProperties.RestorePolicy = expandBlobPropertiesRestorePolicy(restorePolicyRaw)
+
 	return props
 }
 
+func expandBlobPropertiesRestorePolicy(input []interface{}) *storage.RestorePolicyProperties {
+	restorePolicy := storage.RestorePolicyProperties{
+		Enabled: utils.Bool(false),
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &restorePolicy
+	}
+
+	policy := input[0].(map[string]interface{})
+	restorePolicy.Enabled = utils.Bool(true)
+	restorePolicy.Days = utils.Int32(int32(policy["days"].(int)))
+
+	return &restorePolicy
+}
+
 func expandBlobPropertiesDeleteRetentionPolicy(input []interface{}) *storage.DeleteRetentionPolicy {
 	deleteRetentionPolicy := storage.DeleteRetentionPolicy{
 		Enabled: utils.Bool(false),
@@ -1772,7 +1910,34 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 		flattenedContainerDeletePolicy = flattenBlobPropertiesDeleteRetentionPolicy(containerDeletePolicy)
 	}
 
-	if len(flattenedCorsRules) == 0 && len(flattenedDeletePolicy) == 0 && len(flattenedContainerDeletePolicy) == 0 {
+	versioningEnabled := false
+	if input.BlobServicePropertiesProperties.IsVersioningEnabled != nil {
+		versioningEnabled = *input.BlobServicePropertiesProperties.IsVersioningEnabled
+	}
+
+	changeFeedEnabled := false
+	changeFeedRetentionInDays := 0
+	if changeFeed := input.BlobServicePropertiesProperties.ChangeFeed; changeFeed != nil {
+		if changeFeed.Enabled != nil {
+			changeFeedEnabled = *changeFeed.Enabled
+		}
+		if changeFeed.RetentionInDays != nil {
+			changeFeedRetentionInDays = int(*changeFeed.RetentionInDays)
+		}
+	}
+
+	lastAccessTimeEnabled := false
+	if lastAccessTimeTrackingPolicy := input.BlobServicePropertiesProperties.LastAccessTimeTrackingPolicy; lastAccessTimeTrackingPolicy != nil && lastAccessTimeTrackingPolicy.Enable != nil {
+		lastAccessTimeEnabled = *lastAccessTimeTrackingPolicy.Enable
+	}
+
+	flattenedRestorePolicy := make([]interface{}, 0)
+	if restorePolicy := input.BlobServicePropertiesProperties.RestorePolicy; restorePolicy != nil {
+		flattenedRestorePolicy = flattenBlobPropertiesRestorePolicy(restorePolicy)
+	}
+
+	if len(flattenedCorsRules) == 0 && len(flattenedDeletePolicy) == 0 && len(flattenedContainerDeletePolicy) == 0 &&
+		!versioningEnabled && !changeFeedEnabled && !lastAccessTimeEnabled && len(flattenedRestorePolicy) == 0 {
 		return []interface{}{}
 	}
 
@@ -1781,10 +1946,36 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 			"cors_rule":                         flattenedCorsRules,
 			"delete_retention_policy":           flattenedDeletePolicy,
 			"container_delete_retention_policy": flattenedContainerDeletePolicy,
+			"versioning_enabled":                versioningEnabled,
+			"change_feed_enabled":               changeFeedEnabled,
+			"change_feed_retention_in_days":     changeFeedRetentionInDays,
+			"last_access_time_enabled":          lastAccessTimeEnabled,
+			"restore_policy":                    flattenedRestorePolicy,
 		},
 	}
 }
 
+func flattenBlobPropertiesRestorePolicy(input *storage.RestorePolicyProperties) []interface{} {
+	restorePolicy := make([]interface{}, 0)
+
+	if input == nil {
+		return restorePolicy
+	}
+
+	if enabled := input.Enabled; enabled != nil && *enabled {
+		days := 0
+		if input.Days != nil {
+			days = int(*input.Days)
+		}
+
+		restorePolicy = append(restorePolicy, map[string]interface{}{
+			"days": days,
+		})
+	}
+
+	return restorePolicy
+}
+
 func flattenBlobPropertiesCorsRule(input *storage.CorsRules) []interface{} {
 	corsRules := make([]interface{}, 0)
 
@@ -53,6 +53,26 @@ func dataSourceStorageManagementPolicy() *schema.Resource {
 										Elem:     &schema.Schema{Type: schema.TypeString},
 										Set:      schema.HashString,
 									},
+									"match_blob_index_tag": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"operation": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -78,6 +98,10 @@ func dataSourceStorageManagementPolicy() *schema.Resource {
 													Type:     schema.TypeInt,
 													Computed: true,
 												},
+												"tier_to_cool_after_days_since_last_access_time_greater_than": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
 											},
 										},
 									},
@@ -86,6 +110,30 @@ func dataSourceStorageManagementPolicy() *schema.Resource {
 										Computed: true,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"tier_to_archive_after_days_since_creation_greater_than": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"delete_after_days_since_creation_greater_than": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"version": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_creation_greater_than": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"tier_to_archive_after_days_since_creation_greater_than": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
 												"delete_after_days_since_creation_greater_than": {
 													Type:     schema.TypeInt,
 													Computed: true,
@@ -81,6 +81,33 @@ func resourceStorageManagementPolicy() *schema.Resource {
 										},
 										Set: schema.HashString,
 									},
+									"match_blob_index_tag": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"operation": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  "==",
+													ValidateFunc: validation.StringInSlice([]string{
+														"==",
+													}, false),
+												},
+												"value": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(0, 256),
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -114,6 +141,12 @@ func resourceStorageManagementPolicy() *schema.Resource {
 													Default:      nil,
 													ValidateFunc: validation.IntAtLeast(0),
 												},
+												"tier_to_cool_after_days_since_last_access_time_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      nil,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
 											},
 										},
 									},
@@ -123,6 +156,35 @@ func resourceStorageManagementPolicy() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"tier_to_archive_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"delete_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"version": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"tier_to_archive_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
 												"delete_after_days_since_creation_greater_than": {
 													Type:         schema.TypeInt,
 													Optional:     true,
@@ -138,7 +200,40 @@ func resourceStorageManagementPolicy() *schema.Resource {
 				},
 			},
 		},
+
+		// `rule` contains multiple items, so the conflict between the `tier_to_cool` fields within
+		// each rule can't be expressed using `ConflictsWith` and is validated here instead
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			return validateStorageManagementPolicyRules(d.Get("rule").([]interface{}))
+		},
+	}
+}
+
+func validateStorageManagementPolicyRules(input []interface{}) error {
+	for _, rule := range input {
+		if rule == nil {
+			continue
+		}
+
+		for _, action := range rule.(map[string]interface{})["actions"].([]interface{}) {
+			if action == nil {
+				continue
+			}
+
+			for _, baseBlob := range action.(map[string]interface{})["base_blob"].([]interface{}) {
+				if baseBlob == nil {
+					continue
+				}
+
+				v := baseBlob.(map[string]interface{})
+				if v["tier_to_cool_after_days_since_modification_greater_than"].(int) != 0 && v["tier_to_cool_after_days_since_last_access_time_greater_than"].(int) != 0 {
+					return fmt.Errorf("`tier_to_cool_after_days_since_modification_greater_than` conflicts with `tier_to_cool_after_days_since_last_access_time_greater_than` within the `base_blob` block of the rule %q", rule.(map[string]interface{})["name"].(string))
+				}
+			}
+		}
 	}
+
+	return nil
 }
 
 func resourceStorageManagementPolicyCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -287,6 +382,19 @@ func expandStorageManagementPolicyRule(d *schema.ResourceData, ruleIndex int) st
 				}
 			}
 			definition.Filters.BlobTypes = &blobTypes
+
+			if tagFiltersRef := filterRef["match_blob_index_tag"].(*schema.Set).List(); len(tagFiltersRef) > 0 {
+				tagFilters := make([]storage.TagFilter, 0)
+				for _, tagFilterRef := range tagFiltersRef {
+					v := tagFilterRef.(map[string]interface{})
+					tagFilters = append(tagFilters, storage.TagFilter{
+						Name:  utils.String(v["name"].(string)),
+						Op:    utils.String(v["operation"].(string)),
+						Value: utils.String(v["value"].(string)),
+					})
+				}
+				definition.Filters.BlobIndexMatch = &tagFilters
+			}
 		}
 	}
 	if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions", ruleIndex)); ok {
@@ -299,6 +407,14 @@ func expandStorageManagementPolicyRule(d *schema.ResourceData, ruleIndex int) st
 					}
 				}
 			}
+			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cool_after_days_since_last_access_time_greater_than", ruleIndex)); ok {
+				if v != nil {
+					if baseBlob.TierToCool == nil {
+						baseBlob.TierToCool = &storage.DateAfterModification{}
+					}
+					baseBlob.TierToCool.DaysAfterLastAccessTimeGreaterThan = utils.Float(float64(v.(int)))
+				}
+			}
 			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", ruleIndex)); ok {
 				if v != nil {
 					baseBlob.TierToArchive = &storage.DateAfterModification{
@@ -318,12 +434,29 @@ func expandStorageManagementPolicyRule(d *schema.ResourceData, ruleIndex int) st
 
 		if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.snapshot", ruleIndex)); ok {
 			snapshot := &storage.ManagementPolicySnapShot{}
+			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.snapshot.0.tier_to_archive_after_days_since_creation_greater_than", ruleIndex)); ok {
+				snapshot.TierToArchive = &storage.DateAfterCreation{DaysAfterCreationGreaterThan: utils.Float(float64(v.(int)))}
+			}
 			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.snapshot.0.delete_after_days_since_creation_greater_than", ruleIndex)); ok {
 				v2 := float64(v.(int))
 				snapshot.Delete = &storage.DateAfterCreation{DaysAfterCreationGreaterThan: &v2}
 			}
 			definition.Actions.Snapshot = snapshot
 		}
+
+		if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.version", ruleIndex)); ok {
+			version := &storage.ManagementPolicyVersion{}
+			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.version.0.tier_to_cool_after_days_since_creation_greater_than", ruleIndex)); ok {
+				version.TierToCool = &storage.DateAfterCreation{DaysAfterCreationGreaterThan: utils.Float(float64(v.(int)))}
+			}
+			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.version.0.tier_to_archive_after_days_since_creation_greater_than", ruleIndex)); ok {
+				version.TierToArchive = &storage.DateAfterCreation{DaysAfterCreationGreaterThan: utils.Float(float64(v.(int)))}
+			}
+			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.version.0.delete_after_days_since_creation_greater_than", ruleIndex)); ok {
+				version.Delete = &storage.DateAfterCreation{DaysAfterCreationGreaterThan: utils.Float(float64(v.(int)))}
+			}
+			definition.Actions.Version = version
+		}
 	}
 
 	rule := storage.ManagementPolicyRule{
@@ -369,6 +502,29 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 					}
 					filter["blob_types"] = blobTypes
 				}
+				if armFilter.BlobIndexMatch != nil {
+					tagFilters := make([]interface{}, 0)
+					for _, armTagFilter := range *armFilter.BlobIndexMatch {
+						name := ""
+						if armTagFilter.Name != nil {
+							name = *armTagFilter.Name
+						}
+						operation := ""
+						if armTagFilter.Op != nil {
+							operation = *armTagFilter.Op
+						}
+						value := ""
+						if armTagFilter.Value != nil {
+							value = *armTagFilter.Value
+						}
+						tagFilters = append(tagFilters, map[string]interface{}{
+							"name":      name,
+							"operation": operation,
+							"value":     value,
+						})
+					}
+					filter["match_blob_index_tag"] = tagFilters
+				}
 				rule["filters"] = [1]interface{}{filter}
 			}
 
@@ -382,6 +538,10 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 						intTemp := int(*armActionBaseBlob.TierToCool.DaysAfterModificationGreaterThan)
 						baseBlob["tier_to_cool_after_days_since_modification_greater_than"] = intTemp
 					}
+					if armActionBaseBlob.TierToCool != nil && armActionBaseBlob.TierToCool.DaysAfterLastAccessTimeGreaterThan != nil {
+						intTemp := int(*armActionBaseBlob.TierToCool.DaysAfterLastAccessTimeGreaterThan)
+						baseBlob["tier_to_cool_after_days_since_last_access_time_greater_than"] = intTemp
+					}
 					if armActionBaseBlob.TierToArchive != nil && armActionBaseBlob.TierToArchive.DaysAfterModificationGreaterThan != nil {
 						intTemp := int(*armActionBaseBlob.TierToArchive.DaysAfterModificationGreaterThan)
 						baseBlob["tier_to_archive_after_days_since_modification_greater_than"] = intTemp
@@ -396,6 +556,10 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 				armActionSnaphost := armAction.Snapshot
 				if armActionSnaphost != nil {
 					snapshot := make(map[string]interface{})
+					if armActionSnaphost.TierToArchive != nil && armActionSnaphost.TierToArchive.DaysAfterCreationGreaterThan != nil {
+						intTemp := int(*armActionSnaphost.TierToArchive.DaysAfterCreationGreaterThan)
+						snapshot["tier_to_archive_after_days_since_creation_greater_than"] = intTemp
+					}
 					if armActionSnaphost.Delete != nil && armActionSnaphost.Delete.DaysAfterCreationGreaterThan != nil {
 						intTemp := int(*armActionSnaphost.Delete.DaysAfterCreationGreaterThan)
 						snapshot["delete_after_days_since_creation_greater_than"] = intTemp
@@ -403,6 +567,24 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 					action["snapshot"] = [1]interface{}{snapshot}
 				}
 
+				armActionVersion := armAction.Version
+				if armActionVersion != nil {
+					version := make(map[string]interface{})
+					if armActionVersion.TierToCool != nil && armActionVersion.TierToCool.DaysAfterCreationGreaterThan != nil {
+						intTemp := int(*armActionVersion.TierToCool.DaysAfterCreationGreaterThan)
+						version["tier_to_cool_after_days_since_creation_greater_than"] = intTemp
+					}
+					if armActionVersion.TierToArchive != nil && armActionVersion.TierToArchive.DaysAfterCreationGreaterThan != nil {
+						intTemp := int(*armActionVersion.TierToArchive.DaysAfterCreationGreaterThan)
+						version["tier_to_archive_after_days_since_creation_greater_than"] = intTemp
+					}
+					if armActionVersion.Delete != nil && armActionVersion.Delete.DaysAfterCreationGreaterThan != nil {
+						intTemp := int(*armActionVersion.Delete.DaysAfterCreationGreaterThan)
+						version["delete_after_days_since_creation_greater_than"] = intTemp
+					}
+					action["version"] = [1]interface{}{version}
+				}
+
 				rule["actions"] = [1]interface{}{action}
 			}
 		}
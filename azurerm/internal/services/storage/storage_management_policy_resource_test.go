@@ -264,6 +264,27 @@ func TestAccStorageManagementPolicy_blobTypes(t *testing.T) {
 	})
 }
 
+func TestAccStorageManagementPolicy_blobIndexMatchAndVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy", "test")
+	r := StorageManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.blobIndexMatchAndVersion(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.0.filters.0.match_blob_index_tag.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rule.0.actions.0.base_blob.0.tier_to_cool_after_days_since_last_access_time_greater_than").HasValue("10"),
+				check.That(data.ResourceName).Key("rule.0.actions.0.snapshot.0.tier_to_archive_after_days_since_creation_greater_than").HasValue("90"),
+				check.That(data.ResourceName).Key("rule.0.actions.0.version.0.tier_to_cool_after_days_since_creation_greater_than").HasValue("30"),
+				check.That(data.ResourceName).Key("rule.0.actions.0.version.0.tier_to_archive_after_days_since_creation_greater_than").HasValue("90"),
+				check.That(data.ResourceName).Key("rule.0.actions.0.version.0.delete_after_days_since_creation_greater_than").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageManagementPolicyResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	storageAccountId := state.Attributes["storage_account_id"]
 	id, err := parse.StorageAccountID(storageAccountId)
@@ -585,3 +606,65 @@ resource "azurerm_storage_management_policy" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (r StorageManagementPolicyResource) blobIndexMatchAndVersion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  account_kind             = "StorageV2"
+
+  blob_properties {
+    versioning_enabled       = true
+    last_access_time_enabled = true
+  }
+}
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  rule {
+    name    = "rule1"
+    enabled = true
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+
+      match_blob_index_tag {
+        name      = "tag1"
+        operation = "=="
+        value     = "val1"
+      }
+    }
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_last_access_time_greater_than = 10
+        delete_after_days_since_modification_greater_than          = 100
+      }
+      snapshot {
+        tier_to_archive_after_days_since_creation_greater_than = 90
+        delete_after_days_since_creation_greater_than          = 30
+      }
+      version {
+        tier_to_cool_after_days_since_creation_greater_than    = 30
+        tier_to_archive_after_days_since_creation_greater_than = 90
+        delete_after_days_since_creation_greater_than          = 3
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,421 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	// when a policy is created on the Destination Storage Account the Policy ID must be `default`, the
+	// Destination Storage Account then generates the Policy ID which must be used for the Source Storage Account
+	objectReplicationPolicyDefaultName = "default"
+
+	objectReplicationCopyOnlyNewObjects = "OnlyNewObjects"
+	objectReplicationCopyEverything     = "Everything"
+
+	// the API represents replicating all existing objects as a minimum creation time of the start of the epoch
+	objectReplicationCopyEverythingTime = "1601-01-01T00:00:00Z"
+)
+
+func resourceStorageObjectReplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageObjectReplicationCreate,
+		Read:   resourceStorageObjectReplicationRead,
+		Update: resourceStorageObjectReplicationUpdate,
+		Delete: resourceStorageObjectReplicationDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ObjectReplicationID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"destination_storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      resourceStorageObjectReplicationRuleHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_container_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.StorageContainerName,
+						},
+
+						"destination_container_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.StorageContainerName,
+						},
+
+						"copy_blobs_created_after": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  objectReplicationCopyOnlyNewObjects,
+							ValidateFunc: validation.Any(
+								validation.IsRFC3339Time,
+								validation.StringInSlice([]string{
+									objectReplicationCopyOnlyNewObjects,
+									objectReplicationCopyEverything,
+								}, false),
+							),
+						},
+
+						"filter_out_blobs_with_prefix": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"source_object_replication_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"destination_object_replication_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageObjectReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	srcAccount, err := parse.StorageAccountID(d.Get("source_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+	dstAccount, err := parse.StorageAccountID(d.Get("destination_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the Policy ID is generated by the Destination Storage Account, so check for an existing policy between these accounts
+	existingList, err := client.List(ctx, dstAccount.ResourceGroup, dstAccount.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existingList.Response) {
+			return fmt.Errorf("checking for presence of existing Object Replication Policies for %s: %+v", *dstAccount, err)
+		}
+	}
+	if existingList.Value != nil {
+		for _, existing := range *existingList.Value {
+			if existing.Name == nil || existing.ObjectReplicationPolicyProperties == nil {
+				continue
+			}
+
+			props := existing.ObjectReplicationPolicyProperties
+			if props.SourceAccount != nil && strings.EqualFold(*props.SourceAccount, srcAccount.Name) &&
+				props.DestinationAccount != nil && strings.EqualFold(*props.DestinationAccount, dstAccount.Name) {
+				srcId := parse.NewObjectReplicationPolicyID(srcAccount.SubscriptionId, srcAccount.ResourceGroup, srcAccount.Name, *existing.Name)
+				dstId := parse.NewObjectReplicationPolicyID(dstAccount.SubscriptionId, dstAccount.ResourceGroup, dstAccount.Name, *existing.Name)
+				return tf.ImportAsExistsError("azurerm_storage_object_replication", parse.NewObjectReplicationID(srcId, dstId).ID())
+			}
+		}
+	}
+
+	props := storage.ObjectReplicationPolicy{
+		ObjectReplicationPolicyProperties: &storage.ObjectReplicationPolicyProperties{
+			SourceAccount:      utils.String(srcAccount.Name),
+			DestinationAccount: utils.String(dstAccount.Name),
+			Rules:              expandStorageObjectReplicationRules(d.Get("rules").(*schema.Set).List()),
+		},
+	}
+
+	dstResp, err := client.CreateOrUpdate(ctx, dstAccount.ResourceGroup, dstAccount.Name, objectReplicationPolicyDefaultName, props)
+	if err != nil {
+		return fmt.Errorf("creating Object Replication Policy for Destination %s: %+v", *dstAccount, err)
+	}
+	if dstResp.Name == nil || *dstResp.Name == "" {
+		return fmt.Errorf("creating Object Replication Policy for Destination %s: `name` was nil", *dstAccount)
+	}
+	if dstResp.ObjectReplicationPolicyProperties == nil {
+		return fmt.Errorf("creating Object Replication Policy for Destination %s: `properties` was nil", *dstAccount)
+	}
+	dstId := parse.NewObjectReplicationPolicyID(dstAccount.SubscriptionId, dstAccount.ResourceGroup, dstAccount.Name, *dstResp.Name)
+
+	// the Source Storage Account requires the Policy ID and Rule IDs which were generated by the Destination
+	props.ObjectReplicationPolicyProperties.Rules = dstResp.ObjectReplicationPolicyProperties.Rules
+	srcId := parse.NewObjectReplicationPolicyID(srcAccount.SubscriptionId, srcAccount.ResourceGroup, srcAccount.Name, dstId.Name)
+	if _, err := client.CreateOrUpdate(ctx, srcId.ResourceGroup, srcId.StorageAccountName, srcId.Name, props); err != nil {
+		// the Destination Policy would otherwise be orphaned (and block retrying), so remove it
+		if _, deleteErr := client.Delete(ctx, dstId.ResourceGroup, dstId.StorageAccountName, dstId.Name); deleteErr != nil {
+			return fmt.Errorf("creating %s: %+v (additionally the Destination %s could not be removed: %+v)", srcId, err, dstId, deleteErr)
+		}
+
+		return fmt.Errorf("creating %s: %+v", srcId, err)
+	}
+
+	d.SetId(parse.NewObjectReplicationID(srcId, dstId).ID())
+	return resourceStorageObjectReplicationRead(d, meta)
+}
+
+func resourceStorageObjectReplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ObjectReplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props := storage.ObjectReplicationPolicy{
+		ObjectReplicationPolicyProperties: &storage.ObjectReplicationPolicyProperties{
+			SourceAccount:      utils.String(id.Src.StorageAccountName),
+			DestinationAccount: utils.String(id.Dst.StorageAccountName),
+			Rules:              expandStorageObjectReplicationRules(d.Get("rules").(*schema.Set).List()),
+		},
+	}
+
+	dstResp, err := client.CreateOrUpdate(ctx, id.Dst.ResourceGroup, id.Dst.StorageAccountName, id.Dst.Name, props)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id.Dst, err)
+	}
+	if dstResp.ObjectReplicationPolicyProperties == nil {
+		return fmt.Errorf("updating %s: `properties` was nil", id.Dst)
+	}
+
+	props.ObjectReplicationPolicyProperties.Rules = dstResp.ObjectReplicationPolicyProperties.Rules
+	if _, err := client.CreateOrUpdate(ctx, id.Src.ResourceGroup, id.Src.StorageAccountName, id.Src.Name, props); err != nil {
+		return fmt.Errorf("updating %s: %+v", id.Src, err)
+	}
+
+	return resourceStorageObjectReplicationRead(d, meta)
+}
+
+func resourceStorageObjectReplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ObjectReplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dstResp, err := client.Get(ctx, id.Dst.ResourceGroup, id.Dst.StorageAccountName, id.Dst.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(dstResp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", id.Dst)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id.Dst, err)
+	}
+
+	srcResp, err := client.Get(ctx, id.Src.ResourceGroup, id.Src.StorageAccountName, id.Src.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(srcResp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", id.Src)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id.Src, err)
+	}
+
+	d.Set("source_storage_account_id", parse.NewStorageAccountID(id.Src.SubscriptionId, id.Src.ResourceGroup, id.Src.StorageAccountName).ID())
+	d.Set("destination_storage_account_id", parse.NewStorageAccountID(id.Dst.SubscriptionId, id.Dst.ResourceGroup, id.Dst.StorageAccountName).ID())
+	d.Set("source_object_replication_id", id.Src.ID())
+	d.Set("destination_object_replication_id", id.Dst.ID())
+
+	// the rules are applied to both Storage Accounts, however the Source is the one which performs the replication
+	if props := srcResp.ObjectReplicationPolicyProperties; props != nil {
+		if err := d.Set("rules", flattenStorageObjectReplicationRules(props.Rules)); err != nil {
+			return fmt.Errorf("setting `rules`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageObjectReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ObjectReplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Source has to be removed first, since it's replicating into the Destination
+	if resp, err := client.Delete(ctx, id.Src.ResourceGroup, id.Src.StorageAccountName, id.Src.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", id.Src, err)
+		}
+	}
+
+	if resp, err := client.Delete(ctx, id.Dst.ResourceGroup, id.Dst.StorageAccountName, id.Dst.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", id.Dst, err)
+		}
+	}
+
+	return nil
+}
+
+func expandStorageObjectReplicationRules(input []interface{}) *[]storage.ObjectReplicationPolicyRule {
+	results := make([]storage.ObjectReplicationPolicyRule, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, storage.ObjectReplicationPolicyRule{
+			SourceContainer:      utils.String(v["source_container_name"].(string)),
+			DestinationContainer: utils.String(v["destination_container_name"].(string)),
+			Filters: &storage.ObjectReplicationPolicyFilter{
+				PrefixMatch:     utils.ExpandStringSlice(v["filter_out_blobs_with_prefix"].(*schema.Set).List()),
+				MinCreationTime: expandStorageObjectReplicationMinCreationTime(v["copy_blobs_created_after"].(string)),
+			},
+		})
+	}
+	return &results
+}
+
+func expandStorageObjectReplicationMinCreationTime(input string) *string {
+	switch input {
+	case objectReplicationCopyOnlyNewObjects:
+		return nil
+	case objectReplicationCopyEverything:
+		return utils.String(objectReplicationCopyEverythingTime)
+	default:
+		return utils.String(normalizeStorageObjectReplicationMinCreationTime(input))
+	}
+}
+
+func flattenStorageObjectReplicationRules(input *[]storage.ObjectReplicationPolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		sourceContainerName := ""
+		if rule.SourceContainer != nil {
+			sourceContainerName = *rule.SourceContainer
+		}
+
+		destinationContainerName := ""
+		if rule.DestinationContainer != nil {
+			destinationContainerName = *rule.DestinationContainer
+		}
+
+		copyBlobsCreatedAfter := objectReplicationCopyOnlyNewObjects
+		prefixes := make([]interface{}, 0)
+		if filters := rule.Filters; filters != nil {
+			if filters.MinCreationTime != nil {
+				copyBlobsCreatedAfter = flattenStorageObjectReplicationMinCreationTime(*filters.MinCreationTime)
+			}
+			prefixes = utils.FlattenStringSlice(filters.PrefixMatch)
+		}
+
+		results = append(results, map[string]interface{}{
+			"source_container_name":        sourceContainerName,
+			"destination_container_name":   destinationContainerName,
+			"copy_blobs_created_after":     copyBlobsCreatedAfter,
+			"filter_out_blobs_with_prefix": prefixes,
+		})
+	}
+	return results
+}
+
+func flattenStorageObjectReplicationMinCreationTime(input string) string {
+	if input == "" {
+		return objectReplicationCopyOnlyNewObjects
+	}
+
+	// the API can return the time with a differing precision, so compare the parsed values
+	if v, err := time.Parse(time.RFC3339, input); err == nil {
+		if epoch, _ := time.Parse(time.RFC3339, objectReplicationCopyEverythingTime); v.Equal(epoch) {
+			return objectReplicationCopyEverything
+		}
+	}
+
+	return normalizeStorageObjectReplicationMinCreationTime(input)
+}
+
+// normalizeStorageObjectReplicationMinCreationTime returns the time in UTC, since the API returns the
+// time in UTC regardless of the offset it was specified with
+func normalizeStorageObjectReplicationMinCreationTime(input string) string {
+	v, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return input
+	}
+
+	return v.UTC().Format(time.RFC3339)
+}
+
+func resourceStorageObjectReplicationRuleHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", m["source_container_name"].(string)))
+		buf.WriteString(fmt.Sprintf("%s-", m["destination_container_name"].(string)))
+
+		// equivalent times (e.g. with a different offset, or the start of the epoch) shouldn't cause a diff
+		buf.WriteString(fmt.Sprintf("%s-", flattenStorageObjectReplicationMinCreationTime(m["copy_blobs_created_after"].(string))))
+
+		if raw, ok := m["filter_out_blobs_with_prefix"]; ok && raw != nil {
+			prefixes := make([]string, 0)
+			switch t := raw.(type) {
+			case *schema.Set:
+				for _, prefix := range t.List() {
+					prefixes = append(prefixes, prefix.(string))
+				}
+			case []interface{}:
+				for _, prefix := range t {
+					prefixes = append(prefixes, prefix.(string))
+				}
+			}
+			sort.Strings(prefixes)
+			buf.WriteString(fmt.Sprintf("%s-", strings.Join(prefixes, ",")))
+		}
+	}
+
+	return schema.HashString(buf.String())
+}
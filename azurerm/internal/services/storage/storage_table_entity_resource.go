@@ -58,8 +58,10 @@ func resourceStorageTableEntity() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"entity": {
-				Type:     schema.TypeMap,
-				Required: true,
+				Type:             schema.TypeMap,
+				Required:         true,
+				ValidateFunc:     ValidateTableEntity,
+				DiffSuppressFunc: tableEntityDiffSuppress,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -77,8 +79,6 @@ func resourceStorageTableEntityCreateUpdate(d *schema.ResourceData, meta interfa
 	tableName := d.Get("table_name").(string)
 	partitionKey := d.Get("partition_key").(string)
 	rowKey := d.Get("row_key").(string)
-	entity := d.Get("entity").(map[string]interface{})
-
 	account, err := storageClient.FindAccount(ctx, accountName)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Account %q for Table %q: %s", accountName, tableName, err)
@@ -117,6 +117,11 @@ func resourceStorageTableEntityCreateUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
+	entity, err := ExpandTableEntity(d.Get("entity").(map[string]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `entity`: %+v", err)
+	}
+
 	input := entities.InsertOrMergeEntityInput{
 		PartitionKey: partitionKey,
 		RowKey:       rowKey,
@@ -161,7 +166,7 @@ func resourceStorageTableEntityRead(d *schema.ResourceData, meta interface{}) er
 	input := entities.GetEntityInput{
 		PartitionKey:  id.PartitionKey,
 		RowKey:        id.RowKey,
-		MetaDataLevel: entities.NoMetaData,
+		MetaDataLevel: entities.FullMetaData,
 	}
 
 	result, err := client.Get(ctx, id.AccountName, id.TableName, input)
@@ -173,7 +178,7 @@ func resourceStorageTableEntityRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("table_name", id.TableName)
 	d.Set("partition_key", id.PartitionKey)
 	d.Set("row_key", id.RowKey)
-	if err := d.Set("entity", flattenEntity(result.Entity)); err != nil {
+	if err := d.Set("entity", FlattenTableEntity(result.Entity, d.Get("entity").(map[string]interface{}))); err != nil {
 		return fmt.Errorf("Error setting `entity` for Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %s", id.PartitionKey, id.RowKey, id.TableName, id.AccountName, account.ResourceGroup, err)
 	}
 
@@ -214,12 +219,3 @@ func resourceStorageTableEntityDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
-
-// The api returns extra information that we already have. We'll remove it here before setting it in state.
-func flattenEntity(entity map[string]interface{}) map[string]interface{} {
-	delete(entity, "PartitionKey")
-	delete(entity, "RowKey")
-	delete(entity, "Timestamp")
-
-	return entity
-}
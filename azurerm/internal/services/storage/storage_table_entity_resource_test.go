@@ -68,6 +68,25 @@ func TestAccTableEntity_update(t *testing.T) {
 	})
 }
 
+func TestAccTableEntity_typed(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.typed(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.Count").HasValue("9223372036854775807"),
+				check.That(data.ResourceName).Key("entity.Count@odata.type").HasValue("Edm.Int64"),
+				check.That(data.ResourceName).Key("entity.Enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("entity.Enabled@odata.type").HasValue("Edm.Boolean"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageTableEntityResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := entities.ParseResourceID(state.ID)
 	if err != nil {
@@ -156,6 +175,38 @@ resource "azurerm_storage_table_entity" "test" {
 `, template, data.RandomInteger, data.RandomInteger)
 }
 
+func (r StorageTableEntityResource) typed(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  partition_key = "test_partition%d"
+  row_key       = "test_row%d"
+  entity = {
+    Foo                     = "Bar"
+    Binary                  = "aGVsbG8gd29ybGQ="
+    "Binary@odata.type"     = "Edm.Binary"
+    Count                   = "9223372036854775807"
+    "Count@odata.type"      = "Edm.Int64"
+    Created                 = "2021-01-02T03:04:05Z"
+    "Created@odata.type"    = "Edm.DateTime"
+    Enabled                 = "true"
+    "Enabled@odata.type"    = "Edm.Boolean"
+    Identifier              = "9b9a4a34-7d3c-4b2a-8c3e-2d6a1f0e5b7c"
+    "Identifier@odata.type" = "Edm.Guid"
+    Ratio                   = "1.5"
+    "Ratio@odata.type"      = "Edm.Double"
+    Size                    = "42"
+    "Size@odata.type"       = "Edm.Int32"
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger)
+}
+
 func (r StorageTableEntityResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
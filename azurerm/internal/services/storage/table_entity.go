@@ -0,0 +1,282 @@
+package storage
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// the type of a property within a Table Entity is specified using an `@odata.type` annotation alongside the property
+// https://docs.microsoft.com/en-us/rest/api/storageservices/payload-format-for-table-service-operations#property-types-in-a-json-feed
+const tableEntityTypeAnnotationSuffix = "@odata.type"
+
+const (
+	tableEntityTypeBinary   = "Edm.Binary"
+	tableEntityTypeBoolean  = "Edm.Boolean"
+	tableEntityTypeDateTime = "Edm.DateTime"
+	tableEntityTypeDouble   = "Edm.Double"
+	tableEntityTypeGuid     = "Edm.Guid"
+	tableEntityTypeInt32    = "Edm.Int32"
+	tableEntityTypeInt64    = "Edm.Int64"
+	tableEntityTypeString   = "Edm.String"
+)
+
+var tableEntityTypes = []string{
+	tableEntityTypeBinary,
+	tableEntityTypeBoolean,
+	tableEntityTypeDateTime,
+	tableEntityTypeDouble,
+	tableEntityTypeGuid,
+	tableEntityTypeInt32,
+	tableEntityTypeInt64,
+	tableEntityTypeString,
+}
+
+// ExpandTableEntity converts the `entity` map into the payload sent to the Table Service - properties without
+// an `@odata.type` annotation are sent as strings, as they always have been
+func ExpandTableEntity(input map[string]interface{}) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		if strings.HasSuffix(k, tableEntityTypeAnnotationSuffix) {
+			continue
+		}
+
+		value := v.(string)
+		propertyType, ok := input[k+tableEntityTypeAnnotationSuffix]
+		if !ok {
+			output[k] = value
+			continue
+		}
+
+		switch propertyType.(string) {
+		case tableEntityTypeBoolean:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q as an %s: %+v", k, tableEntityTypeBoolean, err)
+			}
+			output[k] = b
+
+		case tableEntityTypeDouble:
+			f, err := parseTableEntityDouble(value)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q as an %s: %+v", k, tableEntityTypeDouble, err)
+			}
+
+			// the type annotation is always sent, since otherwise an integral value (e.g. `2`) is inferred as an Int32 -
+			// and NaN and the Infinities can't be represented in JSON, so these are sent as strings
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				output[k] = value
+			} else {
+				output[k] = f
+			}
+			output[k+tableEntityTypeAnnotationSuffix] = tableEntityTypeDouble
+
+		case tableEntityTypeInt32:
+			i, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q as an %s: %+v", k, tableEntityTypeInt32, err)
+			}
+			output[k] = int32(i)
+
+		case tableEntityTypeString:
+			output[k] = value
+
+		default:
+			// Binary, DateTime, Guid and Int64 values are sent as strings alongside their type annotation
+			output[k] = value
+			output[k+tableEntityTypeAnnotationSuffix] = propertyType
+		}
+	}
+
+	return output, nil
+}
+
+// FlattenTableEntity converts an Entity retrieved from the Table Service using `fullmetadata` into the `entity` map,
+// adding an `@odata.type` annotation for any property which isn't a string. Since integral Doubles can't be told
+// apart from Int32's in the response, the annotations within `existing` (the configured/prior `entity`) are used
+// to disambiguate these.
+func FlattenTableEntity(input map[string]interface{}, existing map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		// the API returns extra information that we already have, which we don't want in the state
+		if k == "PartitionKey" || k == "RowKey" || k == "Timestamp" || strings.HasPrefix(k, "odata.") || strings.HasSuffix(k, tableEntityTypeAnnotationSuffix) {
+			continue
+		}
+
+		propertyType := ""
+		if t, ok := input[k+tableEntityTypeAnnotationSuffix].(string); ok {
+			propertyType = t
+		}
+
+		// Boolean, Int32 and (with the exception of NaN & the Infinities) Double values aren't annotated by the API
+		// and instead are inferred from the JSON type
+		var value string
+		switch t := v.(type) {
+		case bool:
+			propertyType = tableEntityTypeBoolean
+			value = strconv.FormatBool(t)
+
+		case float64:
+			if propertyType == "" {
+				propertyType = tableEntityTypeInt32
+				if existingType, ok := existing[k+tableEntityTypeAnnotationSuffix].(string); (ok && existingType == tableEntityTypeDouble) || t != math.Trunc(t) {
+					propertyType = tableEntityTypeDouble
+				}
+			}
+
+			if propertyType == tableEntityTypeInt32 {
+				value = strconv.FormatInt(int64(t), 10)
+			} else {
+				value = strconv.FormatFloat(t, 'f', -1, 64)
+			}
+
+		case string:
+			value = t
+
+		default:
+			log.Printf("[WARN] Skipping property %q of unexpected type %T within the Table Entity", k, v)
+			continue
+		}
+
+		output[k] = value
+		if propertyType != "" && propertyType != tableEntityTypeString {
+			output[k+tableEntityTypeAnnotationSuffix] = propertyType
+		}
+	}
+
+	return output
+}
+
+func ValidateTableEntity(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be a map", k))
+		return
+	}
+
+	for key, value := range v {
+		if !strings.HasSuffix(key, tableEntityTypeAnnotationSuffix) {
+			continue
+		}
+
+		propertyName := strings.TrimSuffix(key, tableEntityTypeAnnotationSuffix)
+		propertyValue, ok := v[propertyName]
+		if !ok {
+			errors = append(errors, fmt.Errorf("%q contains the type annotation %q but no property named %q", k, key, propertyName))
+			continue
+		}
+
+		propertyType, _ := value.(string)
+		if err := validateTableEntityValue(propertyType, propertyValue.(string)); err != nil {
+			errors = append(errors, fmt.Errorf("%q: property %q: %+v", k, propertyName, err))
+		}
+	}
+
+	return
+}
+
+func validateTableEntityValue(propertyType, value string) error {
+	var err error
+	switch propertyType {
+	case tableEntityTypeBinary:
+		_, err = base64.StdEncoding.DecodeString(value)
+	case tableEntityTypeBoolean:
+		_, err = strconv.ParseBool(value)
+	case tableEntityTypeDateTime:
+		_, err = time.Parse(time.RFC3339, value)
+	case tableEntityTypeDouble:
+		_, err = parseTableEntityDouble(value)
+	case tableEntityTypeGuid:
+		_, err = uuid.ParseUUID(value)
+	case tableEntityTypeInt32:
+		_, err = strconv.ParseInt(value, 10, 32)
+	case tableEntityTypeInt64:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case tableEntityTypeString:
+	default:
+		return fmt.Errorf("unsupported type %q - supported types are %s", propertyType, strings.Join(tableEntityTypes, ", "))
+	}
+
+	if err != nil {
+		return fmt.Errorf("value %q is not a valid %s: %+v", value, propertyType, err)
+	}
+
+	return nil
+}
+
+// tableEntityDiffSuppress suppresses the diff between two representations of the same typed value, for example
+// `1.50` and `1.5` for an `Edm.Double` or the casing of an `Edm.Guid`
+func tableEntityDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" || strings.HasSuffix(k, "%") || strings.HasSuffix(k, tableEntityTypeAnnotationSuffix) {
+		return false
+	}
+
+	entity := d.Get("entity").(map[string]interface{})
+	propertyType, ok := entity[strings.TrimPrefix(k, "entity.")+tableEntityTypeAnnotationSuffix].(string)
+	if !ok {
+		return false
+	}
+
+	switch propertyType {
+	case tableEntityTypeDateTime:
+		oldTime, err := time.Parse(time.RFC3339, old)
+		if err != nil {
+			return false
+		}
+		newTime, err := time.Parse(time.RFC3339, new)
+		if err != nil {
+			return false
+		}
+		return oldTime.Equal(newTime)
+
+	case tableEntityTypeDouble:
+		oldDouble, err := parseTableEntityDouble(old)
+		if err != nil {
+			return false
+		}
+		newDouble, err := parseTableEntityDouble(new)
+		if err != nil {
+			return false
+		}
+		return oldDouble == newDouble || (math.IsNaN(oldDouble) && math.IsNaN(newDouble))
+
+	case tableEntityTypeGuid:
+		return strings.EqualFold(old, new)
+
+	case tableEntityTypeBoolean:
+		oldBool, err := strconv.ParseBool(old)
+		if err != nil {
+			return false
+		}
+		newBool, err := strconv.ParseBool(new)
+		if err != nil {
+			return false
+		}
+		return oldBool == newBool
+	}
+
+	return false
+}
+
+func parseTableEntityDouble(input string) (float64, error) {
+	// the Table Service uses `INF` and `-INF` for the Infinities
+	switch input {
+	case "NaN":
+		return math.NaN(), nil
+	case "INF":
+		return math.Inf(1), nil
+	case "-INF":
+		return math.Inf(-1), nil
+	}
+
+	return strconv.ParseFloat(input, 64)
+}
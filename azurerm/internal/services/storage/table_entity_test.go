@@ -0,0 +1,252 @@
+package storage_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+)
+
+func TestExpandTableEntity(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+		Error    bool
+	}{
+		{
+			Name: "Untyped",
+			Input: map[string]interface{}{
+				"Foo":   "Bar",
+				"Count": "5",
+			},
+			Expected: map[string]interface{}{
+				"Foo":   "Bar",
+				"Count": "5",
+			},
+		},
+		{
+			Name: "Typed",
+			Input: map[string]interface{}{
+				"Binary":              "aGVsbG8=",
+				"Binary@odata.type":   "Edm.Binary",
+				"Boolean":             "true",
+				"Boolean@odata.type":  "Edm.Boolean",
+				"DateTime":            "2021-01-02T03:04:05Z",
+				"DateTime@odata.type": "Edm.DateTime",
+				"Double":              "1.5",
+				"Double@odata.type":   "Edm.Double",
+				"Guid":                "00000000-0000-0000-0000-000000000001",
+				"Guid@odata.type":     "Edm.Guid",
+				"Int32":               "12",
+				"Int32@odata.type":    "Edm.Int32",
+				"Int64":               "9223372036854775807",
+				"Int64@odata.type":    "Edm.Int64",
+				"String":              "hello",
+				"String@odata.type":   "Edm.String",
+				"Infinity":            "INF",
+				"Infinity@odata.type": "Edm.Double",
+				"Unannotated":         "true",
+			},
+			Expected: map[string]interface{}{
+				"Binary":              "aGVsbG8=",
+				"Binary@odata.type":   "Edm.Binary",
+				"Boolean":             true,
+				"DateTime":            "2021-01-02T03:04:05Z",
+				"DateTime@odata.type": "Edm.DateTime",
+				"Double":              1.5,
+				"Double@odata.type":   "Edm.Double",
+				"Guid":                "00000000-0000-0000-0000-000000000001",
+				"Guid@odata.type":     "Edm.Guid",
+				"Int32":               int32(12),
+				"Int64":               "9223372036854775807",
+				"Int64@odata.type":    "Edm.Int64",
+				"String":              "hello",
+				"Infinity":            "INF",
+				"Infinity@odata.type": "Edm.Double",
+				"Unannotated":         "true",
+			},
+		},
+		{
+			Name: "Integral Double",
+			Input: map[string]interface{}{
+				"Double":            "2",
+				"Double@odata.type": "Edm.Double",
+			},
+			Expected: map[string]interface{}{
+				"Double":            float64(2),
+				"Double@odata.type": "Edm.Double",
+			},
+		},
+		{
+			Name: "Invalid Int32",
+			Input: map[string]interface{}{
+				"Int32":            "9223372036854775807",
+				"Int32@odata.type": "Edm.Int32",
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := storage.ExpandTableEntity(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error for %q but got: %+v", v.Name, err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error for %q but didn't get one", v.Name)
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("expected %+v for %q but got %+v", v.Expected, v.Name, actual)
+		}
+	}
+}
+
+func TestFlattenTableEntity(t *testing.T) {
+	// a response from the Table Service using `fullmetadata`, as decoded from JSON
+	input := map[string]interface{}{
+		"odata.metadata":       "https://example.table.core.windows.net/$metadata#table1/@Element",
+		"odata.type":           "example.table1",
+		"odata.etag":           "W/\"datetime'2021-01-02T03%3A04%3A05.0000000Z'\"",
+		"PartitionKey":         "partition",
+		"RowKey":               "row",
+		"Timestamp@odata.type": "Edm.DateTime",
+		"Timestamp":            "2021-01-02T03:04:05.0000000Z",
+		"Binary@odata.type":    "Edm.Binary",
+		"Binary":               "aGVsbG8=",
+		"Boolean":              true,
+		"DateTime@odata.type":  "Edm.DateTime",
+		"DateTime":             "2021-01-02T03:04:05Z",
+		"Double@odata.type":    "Edm.Double",
+		"Double":               float64(1.5),
+		"Guid@odata.type":      "Edm.Guid",
+		"Guid":                 "00000000-0000-0000-0000-000000000001",
+		"Int32":                float64(12),
+		"UnannotatedDouble":    float64(1.5),
+		"IntegralDouble":       float64(2),
+		"Int64@odata.type":     "Edm.Int64",
+		"Int64":                "9223372036854775807",
+		"Infinity@odata.type":  "Edm.Double",
+		"Infinity":             "INF",
+		"String":               "hello",
+		"UnexpectedNull":       nil,
+	}
+	expected := map[string]interface{}{
+		"Binary@odata.type":            "Edm.Binary",
+		"Binary":                       "aGVsbG8=",
+		"Boolean@odata.type":           "Edm.Boolean",
+		"Boolean":                      "true",
+		"DateTime@odata.type":          "Edm.DateTime",
+		"DateTime":                     "2021-01-02T03:04:05Z",
+		"Double@odata.type":            "Edm.Double",
+		"Double":                       "1.5",
+		"Guid@odata.type":              "Edm.Guid",
+		"Guid":                         "00000000-0000-0000-0000-000000000001",
+		"Int32@odata.type":             "Edm.Int32",
+		"Int32":                        "12",
+		"UnannotatedDouble@odata.type": "Edm.Double",
+		"UnannotatedDouble":            "1.5",
+		"IntegralDouble@odata.type":    "Edm.Double",
+		"IntegralDouble":               "2",
+		"Int64@odata.type":             "Edm.Int64",
+		"Int64":                        "9223372036854775807",
+		"Infinity@odata.type":          "Edm.Double",
+		"Infinity":                     "INF",
+		"String":                       "hello",
+	}
+
+	// the configured/prior entity, used to disambiguate integral Doubles from Int32's
+	existing := map[string]interface{}{
+		"Int32":                     "12",
+		"IntegralDouble@odata.type": "Edm.Double",
+		"IntegralDouble":            "2",
+	}
+
+	actual := storage.FlattenTableEntity(input, existing)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestValidateTableEntity(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name:  "Untyped",
+			Input: map[string]interface{}{"Foo": "Bar"},
+			Valid: true,
+		},
+		{
+			Name: "Typed",
+			Input: map[string]interface{}{
+				"Binary":              "aGVsbG8=",
+				"Binary@odata.type":   "Edm.Binary",
+				"Boolean":             "false",
+				"Boolean@odata.type":  "Edm.Boolean",
+				"DateTime":            "2021-01-02T03:04:05Z",
+				"DateTime@odata.type": "Edm.DateTime",
+				"Double":              "-INF",
+				"Double@odata.type":   "Edm.Double",
+				"Guid":                "00000000-0000-0000-0000-000000000001",
+				"Guid@odata.type":     "Edm.Guid",
+				"Int32":               "-12",
+				"Int32@odata.type":    "Edm.Int32",
+				"Int64":               "9223372036854775807",
+				"Int64@odata.type":    "Edm.Int64",
+				"String":              "5",
+				"String@odata.type":   "Edm.String",
+			},
+			Valid: true,
+		},
+		{
+			Name:  "Annotation Without Property",
+			Input: map[string]interface{}{"Foo@odata.type": "Edm.Int64"},
+			Valid: false,
+		},
+		{
+			Name:  "Unsupported Type",
+			Input: map[string]interface{}{"Foo": "Bar", "Foo@odata.type": "Edm.Byte"},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid Boolean",
+			Input: map[string]interface{}{"Foo": "yes", "Foo@odata.type": "Edm.Boolean"},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid DateTime",
+			Input: map[string]interface{}{"Foo": "2021-01-02", "Foo@odata.type": "Edm.DateTime"},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid Guid",
+			Input: map[string]interface{}{"Foo": "not-a-guid", "Foo@odata.type": "Edm.Guid"},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid Int64",
+			Input: map[string]interface{}{"Foo": "1.5", "Foo@odata.type": "Edm.Int64"},
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		_, errors := storage.ValidateTableEntity(v.Input, "entity")
+		actual := len(errors) == 0
+		if v.Valid != actual {
+			t.Fatalf("expected %q to be %t but got %t: %+v", v.Name, v.Valid, actual, errors)
+		}
+	}
+}
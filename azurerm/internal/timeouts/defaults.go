@@ -0,0 +1,137 @@
+package timeouts
+
+import (
+	"context"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ResourceTypeDefaults defines default timeouts configured at the Provider level
+// which apply to all Resources whose type matches ResourceType
+type ResourceTypeDefaults struct {
+	// ResourceType is either the name of a Resource Type (e.g. `azurerm_kubernetes_cluster`)
+	// or a glob matching multiple Resource Types (e.g. `azurerm_kubernetes_*`)
+	ResourceType string
+
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+}
+
+type providerDefaultsKey struct{}
+
+type resourceScopeKey struct{}
+
+// resourceScope is the Resource Type (and its default timeouts) which a context is scoped to
+type resourceScope struct {
+	resourceType string
+	timeouts     *schema.ResourceTimeout
+}
+
+// WithProviderDefaults returns a copy of the context containing the default timeouts configured
+// at the Provider level, which are used by ForCreate/ForRead/ForUpdate/ForDelete when the context
+// has been scoped to a Resource Type using WithResourceType
+func WithProviderDefaults(ctx context.Context, defaults []ResourceTypeDefaults) context.Context {
+	return context.WithValue(ctx, providerDefaultsKey{}, defaults)
+}
+
+// WithResourceType returns a copy of the context scoped to the specified Resource (or Data Source),
+// so that the default timeouts configured at the Provider level for it can be determined
+func WithResourceType(ctx context.Context, resourceType string, resource *schema.Resource) context.Context {
+	return context.WithValue(ctx, resourceScopeKey{}, resourceScope{
+		resourceType: resourceType,
+		timeouts:     resource.Timeouts,
+	})
+}
+
+// determineTimeout returns the timeout for the specified operation - which is the default timeout
+// configured at the Provider level for this Resource Type when one matches, unless the timeout
+// has been overridden in the `timeouts` block for this instance of the Resource.
+//
+// Since the Plugin SDK only exposes the resolved timeout, a timeout matching the Resource's own
+// default is treated as not being overridden. Only operations which the Resource supports custom
+// timeouts for are overridden.
+func determineTimeout(ctx context.Context, d *schema.ResourceData, key string) time.Duration {
+	timeout := d.Timeout(key)
+
+	defaults, ok := ctx.Value(providerDefaultsKey{}).([]ResourceTypeDefaults)
+	if !ok || len(defaults) == 0 {
+		return timeout
+	}
+	scope, ok := ctx.Value(resourceScopeKey{}).(resourceScope)
+	if !ok || scope.timeouts == nil {
+		return timeout
+	}
+
+	var resourceDefault *time.Duration
+	var selector func(input ResourceTypeDefaults) *time.Duration
+	switch key {
+	case schema.TimeoutCreate:
+		resourceDefault = scope.timeouts.Create
+		selector = func(input ResourceTypeDefaults) *time.Duration { return input.Create }
+	case schema.TimeoutRead:
+		resourceDefault = scope.timeouts.Read
+		selector = func(input ResourceTypeDefaults) *time.Duration { return input.Read }
+	case schema.TimeoutUpdate:
+		resourceDefault = scope.timeouts.Update
+		selector = func(input ResourceTypeDefaults) *time.Duration { return input.Update }
+	case schema.TimeoutDelete:
+		resourceDefault = scope.timeouts.Delete
+		selector = func(input ResourceTypeDefaults) *time.Duration { return input.Delete }
+	}
+	if resourceDefault == nil || *resourceDefault != timeout {
+		return timeout
+	}
+
+	if v := providerDefault(scope.resourceType, defaults, selector); v != nil {
+		return *v
+	}
+
+	return timeout
+}
+
+// providerDefault returns the timeout from the most specific item in `defaults` which matches
+// the Resource Type and defines a timeout for this operation, if any
+func providerDefault(resourceType string, defaults []ResourceTypeDefaults, selector func(input ResourceTypeDefaults) *time.Duration) *time.Duration {
+	var value *time.Duration
+	specificity := -1
+	for _, v := range defaults {
+		timeout := selector(v)
+		if timeout == nil {
+			continue
+		}
+
+		matched, err := path.Match(v.ResourceType, resourceType)
+		if err != nil {
+			// the patterns are validated in the Provider block, so this shouldn't happen
+			log.Printf("[WARN] matching Resource Type %q against %q: %+v", resourceType, v.ResourceType, err)
+			continue
+		}
+		if !matched {
+			continue
+		}
+
+		if s := patternSpecificity(v.ResourceType); s > specificity {
+			value = timeout
+			specificity = s
+		}
+	}
+
+	return value
+}
+
+// patternSpecificity returns how specific a Resource Type pattern is - where an exact
+// Resource Type is more specific than any glob, and longer globs are more specific
+func patternSpecificity(pattern string) int {
+	if !strings.ContainsAny(pattern, "*?[") {
+		// exact matches always win
+		return int(^uint(0) >> 1)
+	}
+
+	return len(pattern)
+}
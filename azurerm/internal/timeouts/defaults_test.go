@@ -0,0 +1,187 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestDetermineTimeout(t *testing.T) {
+	duration := func(input time.Duration) *time.Duration {
+		return &input
+	}
+
+	testData := []struct {
+		Name         string
+		ResourceType string
+		Defaults     []ResourceTypeDefaults
+		Configured   *schema.ResourceTimeout
+		Expected     schema.ResourceTimeout
+	}{
+		{
+			Name:         "No Defaults",
+			ResourceType: "azurerm_kubernetes_cluster",
+			Expected: schema.ResourceTimeout{
+				Create: duration(90 * time.Minute),
+				Read:   duration(5 * time.Minute),
+				Delete: duration(90 * time.Minute),
+			},
+		},
+		{
+			Name:         "No Match",
+			ResourceType: "azurerm_kubernetes_cluster",
+			Defaults: []ResourceTypeDefaults{
+				{
+					ResourceType: "azurerm_storage_*",
+					Create:       duration(time.Hour),
+				},
+			},
+			Expected: schema.ResourceTimeout{
+				Create: duration(90 * time.Minute),
+				Read:   duration(5 * time.Minute),
+				Delete: duration(90 * time.Minute),
+			},
+		},
+		{
+			Name:         "Exact Match",
+			ResourceType: "azurerm_kubernetes_cluster",
+			Defaults: []ResourceTypeDefaults{
+				{
+					ResourceType: "azurerm_kubernetes_cluster",
+					Create:       duration(3 * time.Hour),
+					Read:         duration(10 * time.Minute),
+				},
+			},
+			Expected: schema.ResourceTimeout{
+				Create: duration(3 * time.Hour),
+				Read:   duration(10 * time.Minute),
+				Delete: duration(90 * time.Minute),
+			},
+		},
+		{
+			Name:         "Unsupported Operation",
+			ResourceType: "azurerm_kubernetes_cluster",
+			Defaults: []ResourceTypeDefaults{
+				{
+					ResourceType: "azurerm_kubernetes_cluster",
+					Update:       duration(3 * time.Hour),
+				},
+			},
+			Expected: schema.ResourceTimeout{
+				Create: duration(90 * time.Minute),
+				Read:   duration(5 * time.Minute),
+				Delete: duration(90 * time.Minute),
+			},
+		},
+		{
+			Name:         "Most Specific Wins",
+			ResourceType: "azurerm_kubernetes_cluster",
+			Defaults: []ResourceTypeDefaults{
+				{
+					ResourceType: "azurerm_kubernetes_cluster",
+					Delete:       duration(4 * time.Hour),
+				},
+				{
+					ResourceType: "azurerm_kubernetes_*",
+					Create:       duration(2 * time.Hour),
+					Delete:       duration(2 * time.Hour),
+				},
+				{
+					ResourceType: "azurerm_*",
+					Create:       duration(time.Hour),
+					Read:         duration(time.Hour),
+				},
+			},
+			Expected: schema.ResourceTimeout{
+				Create: duration(2 * time.Hour),
+				Read:   duration(time.Hour),
+				Delete: duration(4 * time.Hour),
+			},
+		},
+		{
+			Name:         "Configured On The Resource",
+			ResourceType: "azurerm_kubernetes_cluster",
+			Defaults: []ResourceTypeDefaults{
+				{
+					ResourceType: "azurerm_kubernetes_*",
+					Create:       duration(2 * time.Hour),
+					Read:         duration(time.Hour),
+				},
+			},
+			Configured: &schema.ResourceTimeout{
+				Create: duration(30 * time.Minute),
+				Read:   duration(5 * time.Minute),
+				Delete: duration(90 * time.Minute),
+			},
+			Expected: schema.ResourceTimeout{
+				Create: duration(30 * time.Minute),
+				Read:   duration(time.Hour),
+				Delete: duration(90 * time.Minute),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		resource := &schema.Resource{
+			Timeouts: &schema.ResourceTimeout{
+				Create: duration(90 * time.Minute),
+				Read:   duration(5 * time.Minute),
+				Delete: duration(90 * time.Minute),
+			},
+		}
+
+		// the timeouts within the ResourceData are those configured on this instance of the Resource
+		configured := resource
+		if v.Configured != nil {
+			configured = &schema.Resource{
+				Timeouts: v.Configured,
+			}
+		}
+		d := configured.Data(&terraform.InstanceState{})
+
+		ctx := WithResourceType(WithProviderDefaults(context.TODO(), v.Defaults), v.ResourceType, resource)
+
+		for key, expected := range map[string]*time.Duration{
+			schema.TimeoutCreate: v.Expected.Create,
+			schema.TimeoutRead:   v.Expected.Read,
+			schema.TimeoutUpdate: v.Expected.Update,
+			schema.TimeoutDelete: v.Expected.Delete,
+		} {
+			// operations which the Resource doesn't support a custom timeout for use the system default
+			value := 20 * time.Minute
+			if expected != nil {
+				value = *expected
+			}
+
+			if actual := determineTimeout(ctx, d, key); actual != value {
+				t.Fatalf("expected the %s timeout to be %v but got %v", key, value, actual)
+			}
+		}
+	}
+}
+
+func TestDetermineTimeoutNotScoped(t *testing.T) {
+	create := 90 * time.Minute
+	override := 3 * time.Hour
+	defaults := []ResourceTypeDefaults{
+		{
+			ResourceType: "azurerm_*",
+			Create:       &override,
+		},
+	}
+	resource := &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: &create,
+		},
+	}
+	d := resource.Data(&terraform.InstanceState{})
+
+	if actual := determineTimeout(WithProviderDefaults(context.TODO(), defaults), d, schema.TimeoutCreate); actual != 90*time.Minute {
+		t.Fatalf("expected the create timeout to be 90m when the context isn't scoped to a Resource Type but got %v", actual)
+	}
+}
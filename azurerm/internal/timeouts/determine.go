@@ -12,7 +12,7 @@ import (
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForCreate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	return buildWithTimeout(ctx, determineTimeout(ctx, d, schema.TimeoutCreate))
 }
 
 // ForCreateUpdate returns the context wrapped with the timeout for an combined Create/Update operation
@@ -32,7 +32,7 @@ func ForCreateUpdate(ctx context.Context, d *schema.ResourceData) (context.Conte
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForDelete(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	return buildWithTimeout(ctx, determineTimeout(ctx, d, schema.TimeoutDelete))
 }
 
 // ForRead returns the context wrapped with the timeout for an Read operation
@@ -40,7 +40,7 @@ func ForDelete(ctx context.Context, d *schema.ResourceData) (context.Context, co
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForRead(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	return buildWithTimeout(ctx, determineTimeout(ctx, d, schema.TimeoutRead))
 }
 
 // ForUpdate returns the context wrapped with the timeout for an Update operation
@@ -48,7 +48,7 @@ func ForRead(ctx context.Context, d *schema.ResourceData) (context.Context, cont
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	return buildWithTimeout(ctx, determineTimeout(ctx, d, schema.TimeoutUpdate))
 }
 
 func buildWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
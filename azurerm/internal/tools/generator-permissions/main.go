@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var importExample = regexp.MustCompile(`^terraform import (azurerm_[a-z0-9_]+)\.[^ ]+ "?(/subscriptions/[^" ]+)"?`)
+
+// overrides is a hand-curated list of Resource Types where the example Resource ID in the Import section of the
+// Website Documentation doesn't map to the Azure Resource Manager Resource Type - either since this is a Terraform
+// specific ID, or since the resource is a property of (and so is written via) another resource.
+//
+// Resource Types mapped to an empty string aren't known and so are skipped, rather than checking the wrong action.
+var overrides = map[string]string{
+	"azurerm_api_management_api_diagnostic":                     "Microsoft.ApiManagement/service/apis/diagnostics",
+	"azurerm_api_management_api_schema":                         "Microsoft.ApiManagement/service/apis/schemas",
+	"azurerm_api_management_custom_domain":                      "Microsoft.ApiManagement/service",
+	"azurerm_app_service_slot_virtual_network_swift_connection": "",
+	"azurerm_app_service_virtual_network_swift_connection":      "",
+	"azurerm_firewall_application_rule_collection":              "Microsoft.Network/azureFirewalls",
+	"azurerm_firewall_nat_rule_collection":                      "Microsoft.Network/azureFirewalls",
+	"azurerm_firewall_network_rule_collection":                  "Microsoft.Network/azureFirewalls",
+	"azurerm_function_app":                                      "Microsoft.Web/sites",
+	"azurerm_iothub_dps_shared_access_policy":                   "Microsoft.Devices/provisioningServices",
+	"azurerm_iothub_endpoint_eventhub":                          "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_endpoint_servicebus_queue":                  "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_endpoint_servicebus_topic":                  "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_endpoint_storage_container":                 "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_enrichment":                                 "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_route":                                      "Microsoft.Devices/IotHubs",
+	"azurerm_iothub_shared_access_policy":                       "Microsoft.Devices/IotHubs",
+	"azurerm_key_vault_access_policy":                           "Microsoft.KeyVault/vaults/accessPolicies",
+	"azurerm_kusto_database_principal":                          "Microsoft.Kusto/Clusters/Databases",
+	"azurerm_lb_backend_address_pool_address":                   "Microsoft.Network/loadBalancers/backendAddressPools",
+	"azurerm_logic_app_action_custom":                           "Microsoft.Logic/workflows",
+	"azurerm_logic_app_action_http":                             "Microsoft.Logic/workflows",
+	"azurerm_logic_app_trigger_custom":                          "Microsoft.Logic/workflows",
+	"azurerm_logic_app_trigger_http_request":                    "Microsoft.Logic/workflows",
+	"azurerm_logic_app_trigger_recurrence":                      "Microsoft.Logic/workflows",
+	"azurerm_network_watcher_flow_log":                          "",
+	"azurerm_private_dns_a_record":                              "Microsoft.Network/privateDnsZones/A",
+	"azurerm_public_ip_prefix":                                  "Microsoft.Network/publicIPPrefixes",
+	"azurerm_site_recovery_protection_container_mapping":        "Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings",
+	"azurerm_virtual_machine_data_disk_attachment":              "Microsoft.Compute/virtualMachines",
+}
+
+func main() {
+	filePath := flag.String("path", "", "The relative path to the root directory")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if err := run(*filePath); err != nil {
+		panic(err)
+	}
+}
+
+func run(rootDirectory string) error {
+	docsDirectory := filepath.Join(rootDirectory, "website", "docs", "r")
+	files, err := ioutil.ReadDir(docsDirectory)
+	if err != nil {
+		return fmt.Errorf("listing the website docs: %+v", err)
+	}
+
+	resourceTypes := make(map[string]string)
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".html.markdown") {
+			continue
+		}
+
+		// the mapping is best-effort (unknown Resource Types are skipped by the permissions check)
+		// so a documentation file which can't be parsed shouldn't block generating the others
+		if err := parseImportExamples(filepath.Join(docsDirectory, file.Name()), resourceTypes); err != nil {
+			log.Printf("[WARN] Skipping %q: %+v", file.Name(), err)
+		}
+	}
+
+	for name, resourceType := range overrides {
+		if resourceType == "" {
+			delete(resourceTypes, name)
+			continue
+		}
+
+		resourceTypes[name] = resourceType
+	}
+
+	names := make([]string, 0)
+	for name := range resourceTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, 0)
+	for _, name := range names {
+		lines = append(lines, fmt.Sprintf("\t%q: %q,", name, resourceTypes[name]))
+	}
+
+	template := `package permissions
+
+// NOTE: this file is generated from the Import section of the website docs - manual changes will be lost
+//       to re-generate this file, run 'make generate' in the root of the repository
+
+// armResourceTypes maps the Terraform Resource Type to the Azure Resource Manager Resource Type
+// which is used to determine the Authorization Action required to create a resource
+var armResourceTypes = map[string]string{
+%s
+}
+`
+	output, err := format.Source([]byte(fmt.Sprintf(template, strings.Join(lines, "\n"))))
+	if err != nil {
+		return fmt.Errorf("formatting the generated file: %+v", err)
+	}
+
+	outputFile := filepath.Join(rootDirectory, "azurerm", "internal", "permissions", "resource_types_gen.go")
+	return ioutil.WriteFile(outputFile, output, 0644)
+}
+
+func parseImportExamples(fileName string, resourceTypes map[string]string) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return fmt.Errorf("opening %q: %+v", fileName, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		matches := importExample.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if len(matches) != 3 {
+			continue
+		}
+
+		if resourceType := armResourceType(matches[2]); resourceType != "" {
+			resourceTypes[matches[1]] = resourceType
+		}
+	}
+
+	return scanner.Err()
+}
+
+// armResourceType returns the Resource Type for the specified Resource ID, for example
+// `Microsoft.Network/virtualNetworks/subnets` - or an empty string if this can't be determined
+func armResourceType(id string) string {
+	// some resources use a composite ID (e.g. `{resourceId}|{name}`) which doesn't map to a single resource
+	if strings.Contains(id, "|") {
+		return ""
+	}
+
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments) < 4 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") {
+		return ""
+	}
+
+	if len(segments) == 4 {
+		return "Microsoft.Resources/subscriptions/resourceGroups"
+	}
+
+	// extension resources are nested within another resource, so the last provider is used
+	providerIndex := -1
+	for i := 4; i < len(segments); i++ {
+		if strings.EqualFold(segments[i], "providers") {
+			providerIndex = i
+		}
+	}
+	if providerIndex == -1 || (len(segments)-providerIndex)%2 != 0 || len(segments)-providerIndex < 4 {
+		return ""
+	}
+
+	resourceType := []string{segments[providerIndex+1]}
+	for i := providerIndex + 2; i < len(segments); i += 2 {
+		resourceType = append(resourceType, segments[i])
+	}
+
+	return strings.Join(resourceType, "/")
+}
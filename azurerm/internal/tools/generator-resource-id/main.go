@@ -240,10 +240,12 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 					key = fmt.Sprintf("%sy", key)
 				}
 
-				// handles `PublicIPAddressesName`
+				// handles `PublicIPAddressesName` and `CustomIpPrefixesName`
 				if strings.HasSuffix(key, "sses") {
 					key = strings.TrimSuffix(key, "sses")
 					key = fmt.Sprintf("%sss", key)
+				} else if strings.HasSuffix(key, "xes") {
+					key = strings.TrimSuffix(key, "es")
 				} else if strings.HasSuffix(key, "s") {
 					key = strings.TrimSuffix(key, "s")
 				}